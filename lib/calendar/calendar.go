@@ -0,0 +1,53 @@
+// Package calendar implements the date arithmetic of occasions, dates are
+// days at midnight UTC
+package calendar
+
+import "time"
+
+// Day returns the day of t at midnight UTC
+func Day(t time.Time) time.Time {
+	y, m, d := t.UTC().Date()
+
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// Anniversary returns the day of date in year, days that do not exist in
+// year such as February 29th fall on the last day of the month
+func Anniversary(date time.Time, year int) time.Time {
+	date = date.UTC()
+
+	// Day 0 of the next month is the last day of the month
+	last := time.Date(year, date.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+
+	day := date.Day()
+	if day > last {
+		day = last
+	}
+
+	return time.Date(year, date.Month(), day, 0, 0, 0, 0, time.UTC)
+}
+
+// Next returns date's next anniversary on or after the day of now
+func Next(date time.Time, now time.Time) time.Time {
+	today := Day(now)
+
+	next := Anniversary(date, today.Year())
+	if next.Before(today) {
+		next = Anniversary(date, today.Year()+1)
+	}
+
+	return next
+}
+
+// IsDue reports whether a reminder of an occurrence on date should be sent
+// now, reminders are sent daysBefore the occurrence and only once, past
+// occurrences are never due
+func IsDue(date time.Time, daysBefore int, lastRemindedAt *time.Time, now time.Time) bool {
+	if date.Before(Day(now)) {
+		return false
+	}
+
+	remindAt := date.AddDate(0, 0, -daysBefore)
+
+	return !now.Before(remindAt) && (lastRemindedAt == nil || lastRemindedAt.Before(remindAt))
+}
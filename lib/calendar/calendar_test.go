@@ -0,0 +1,64 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestNext(t *testing.T) {
+	tests := []struct {
+		name string
+		date time.Time
+		now  time.Time
+		want time.Time
+	}{
+		{"later this year", date(1990, 6, 15), date(2021, 3, 1), date(2021, 6, 15)},
+		{"today", date(1990, 6, 15), time.Date(2021, 6, 15, 23, 0, 0, 0, time.UTC), date(2021, 6, 15)},
+		{"year rollover", date(1990, 1, 10), date(2021, 12, 31), date(2022, 1, 10)},
+		{"february 29th in leap year", date(1992, 2, 29), date(2024, 1, 1), date(2024, 2, 29)},
+		{"february 29th in other years", date(1992, 2, 29), date(2021, 1, 1), date(2021, 2, 28)},
+		{"february 29th after 28th", date(1992, 2, 29), date(2023, 3, 1), date(2024, 2, 29)},
+		{"time zone of now", date(1990, 6, 15), time.Date(2021, 6, 16, 1, 0, 0, 0, time.FixedZone("IRDT", 16200)), date(2021, 6, 15)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Next(tt.date, tt.now); !got.Equal(tt.want) {
+				t.Fatalf("Next() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsDue(t *testing.T) {
+	reminded := func(t time.Time) *time.Time { return &t }
+
+	tests := []struct {
+		name           string
+		date           time.Time
+		daysBefore     int
+		lastRemindedAt *time.Time
+		now            time.Time
+		want           bool
+	}{
+		{"before reminder window", date(2021, 6, 15), 7, nil, date(2021, 6, 7), false},
+		{"reminder window started", date(2021, 6, 15), 7, nil, date(2021, 6, 8), true},
+		{"day of occurrence", date(2021, 6, 15), 7, nil, time.Date(2021, 6, 15, 12, 0, 0, 0, time.UTC), true},
+		{"already reminded", date(2021, 6, 15), 7, reminded(date(2021, 6, 9)), date(2021, 6, 10), false},
+		{"reminded of previous occurrence", date(2021, 6, 15), 7, reminded(date(2020, 6, 9)), date(2021, 6, 10), true},
+		{"past one-off occurrence", date(2021, 6, 15), 7, nil, date(2021, 6, 16), false},
+		{"past one-off occurrence never reminded", date(2020, 1, 1), 0, nil, date(2021, 6, 16), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsDue(tt.date, tt.daysBefore, tt.lastRemindedAt, tt.now); got != tt.want {
+				t.Fatalf("IsDue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/jinzhu/gorm"
+	"github.com/ryakosh/wishlist/lib"
+	"github.com/ryakosh/wishlist/lib/db"
+)
+
+// Role is used to indicate what a user is allowed to do in the app
+type Role string
+
+const (
+	// RoleUser is the role of regular users
+	RoleUser Role = "user"
+
+	// RoleAdmin is the role of users that can moderate the app
+	RoleAdmin Role = "admin"
+)
+
+// AdminActionKind is used to indicate what an admin has done
+type AdminActionKind string
+
+const (
+	ActionSuspendUser      AdminActionKind = "suspend_user"
+	ActionUnsuspendUser    AdminActionKind = "unsuspend_user"
+	ActionForceVerifyEmail AdminActionKind = "force_verify_email"
+	ActionDeleteWish       AdminActionKind = "delete_wish"
+	ActionResolveReport    AdminActionKind = "resolve_report"
+	ActionSetRole          AdminActionKind = "set_role"
+)
+
+// AdminTargetType is used to indicate the type of the resource
+// that an admin action was performed on
+type AdminTargetType string
+
+const (
+	TargetUser   AdminTargetType = "user"
+	TargetWish   AdminTargetType = "wish"
+	TargetReport AdminTargetType = "report"
+)
+
+var (
+	// ErrUserSuspended is returned when user's account is suspended
+	ErrUserSuspended = errors.New("User is suspended")
+
+	// ErrUserNotSuspended is returned when user's account is not suspended
+	ErrUserNotSuspended = errors.New("User is not suspended")
+)
+
+// AdminAction is a table that records every action taken by admins,
+// it's used for auditing moderation
+type AdminAction struct {
+	ID         int
+	AdminID    *string         `gorm:"type:varchar(64);index"` // Nil when admin's account is deleted
+	Action     AdminActionKind `gorm:"type:varchar(32)"`
+	TargetType AdminTargetType `gorm:"type:varchar(16)"`
+	TargetID   *string         `gorm:"type:varchar(64)"` // Nil when the target user is deleted
+	Reason     *string         `gorm:"type:varchar(1024)"`
+	CreatedAt  *time.Time
+}
+
+// RecordAdminAction is used to record an action taken by admin, tx should be
+// the transaction that the action itself is performed in
+func RecordAdminAction(tx *gorm.DB, admin string, action AdminActionKind,
+	targetType AdminTargetType, targetID string, reason *string) error {
+	return tx.Create(&AdminAction{
+		AdminID:    &admin,
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   &targetID,
+		Reason:     reason,
+	}).Error
+}
+
+// AdminOnly is a middleware that is used to indicate that only admins
+// can access this endpoint, it should be called after the AuthRequired
+// middleware
+func AdminOnly(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+	if AuthedRoleFromCtx(ctx) != RoleAdmin {
+		return nil, ErrUserNotAuthorized
+	}
+
+	return next(ctx)
+}
+
+// promoteAdmins is used to give the admin role to the comma separated
+// list of usernames in WISHLIST_ADMINS environment variable, so that the
+// first admins can be created without accessing the database, it does
+// nothing once any admin exists so that demoting a listed user is not
+// undone by the next restart
+func promoteAdmins() {
+	var admins []string
+	var count int
+
+	for _, a := range strings.Split(os.Getenv("WISHLIST_ADMINS"), ",") {
+		if a = strings.TrimSpace(a); a != "" {
+			admins = append(admins, a)
+		}
+	}
+
+	if len(admins) == 0 {
+		return
+	}
+
+	d := db.DB.Model(&User{}).Where("role = ?", RoleAdmin).Count(&count)
+	if d.Error != nil {
+		lib.LogError(lib.LFatal, "Could not read admins", d.Error)
+	}
+
+	if count != 0 {
+		return
+	}
+
+	d = db.DB.Model(&User{}).Where("id IN (?)", admins).Update("role", RoleAdmin)
+	if d.Error != nil {
+		lib.LogError(lib.LFatal, "Could not promote admins", d.Error)
+	}
+}
+
+func init() {
+	db.DB.AutoMigrate(&AdminAction{})
+}
@@ -0,0 +1,178 @@
+package model
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/jinzhu/gorm"
+	"github.com/ryakosh/wishlist/lib"
+	"github.com/ryakosh/wishlist/lib/db"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// APITokenPrefix is the prefix of all personal API tokens, it's used to tell
+// them apart from JWT access tokens and to make leaked tokens easy to find
+const APITokenPrefix = "wl_"
+
+// apiTokenTouchInterval is the minimum duration between updates of
+// token's last used timestamp
+const apiTokenTouchInterval = time.Minute
+
+// Scope is a permission that can be granted to personal API tokens
+type Scope string
+
+const (
+	// ScopeRead allows reading data using queries
+	ScopeRead Scope = "read"
+
+	// ScopeWrite allows changing data using mutations
+	ScopeWrite Scope = "write"
+
+	// ScopeAccount allows managing user's account and credentials, it's
+	// only granted to sessions and can not be granted to API tokens
+	ScopeAccount Scope = "account"
+)
+
+// sessionScopes are the scopes granted to users that are logged in
+// using a session
+var sessionScopes = []Scope{ScopeRead, ScopeWrite, ScopeAccount}
+
+var (
+	// ErrAPITokenNotFound is returned when API token does not exist in the database
+	ErrAPITokenNotFound = errors.New("API token not found")
+
+	// ErrInsufficientScope is returned when the credentials used for
+	// authentication do not grant the scope required by an operation
+	ErrInsufficientScope = errors.New("Insufficient scope")
+)
+
+// APIToken represents a named, long-lived token that users can use in their
+// scripts and integrations instead of their password, only token's hash is stored
+type APIToken struct {
+	ID         int
+	UserID     string `gorm:"type:varchar(64);index"`
+	Name       string `gorm:"type:varchar(64)"`
+	Hash       string `gorm:"type:varchar(64);unique_index"`
+	Scopes     string `gorm:"type:varchar(256)"` // Comma separated list of scopes
+	LastUsedAt *time.Time
+	ExpiresAt  *time.Time
+	CreatedAt  *time.Time
+}
+
+// ScopeList returns token's scopes
+func (t *APIToken) ScopeList() []Scope {
+	var scopes []Scope
+
+	for _, s := range strings.Split(t.Scopes, ",") {
+		if s != "" {
+			scopes = append(scopes, Scope(s))
+		}
+	}
+
+	return scopes
+}
+
+func hashAPIToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// CreateAPIToken is used to create a new API token for the user, the
+// plain token is only returned once
+func CreateAPIToken(username string, name string, scopes []Scope, expiresAt *time.Time) (*APIToken, string) {
+	var s []string
+
+	rands, _, err := lib.GenSafeRandomBytes(32)
+	if err != nil {
+		lib.LogError(lib.LPanic, "Could not generate API token", err)
+	}
+
+	for _, scope := range scopes {
+		s = append(s, string(scope))
+	}
+
+	plain := APITokenPrefix + base64.RawURLEncoding.EncodeToString(rands)
+	token := APIToken{
+		UserID:    username,
+		Name:      name,
+		Hash:      hashAPIToken(plain),
+		Scopes:    strings.Join(s, ","),
+		ExpiresAt: expiresAt,
+	}
+
+	d := db.DB.Create(&token)
+	if d.Error != nil {
+		lib.LogError(lib.LPanic, "Could not create API token", d.Error)
+	}
+
+	return &token, plain
+}
+
+// VerifyAPIToken is used to find the API token that plain belongs to
+// and record it's usage
+func VerifyAPIToken(plain string) (*APIToken, error) {
+	var token APIToken
+
+	d := db.DB.Where("hash = ?", hashAPIToken(plain)).First(&token)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read API token", d.Error)
+	} else if d.RecordNotFound() {
+		return nil, lib.ErrTokenIsInvalid
+	}
+
+	now := time.Now().UTC()
+	if token.ExpiresAt != nil && now.After(token.ExpiresAt.UTC()) {
+		return nil, lib.ErrTokenHasExpired
+	}
+
+	if token.LastUsedAt == nil || now.Sub(token.LastUsedAt.UTC()) > apiTokenTouchInterval {
+		d := db.DB.Model(&token).Update("last_used_at", now)
+		if d.Error != nil {
+			lib.LogError(lib.LPanic, "Could not update API token", d.Error)
+		}
+	}
+
+	return &token, nil
+}
+
+func hasScope(scopes []Scope, scope Scope) bool {
+	for _, s := range scopes {
+		if s == scope {
+			return true
+		}
+	}
+
+	return false
+}
+
+// operationScope returns the scope that is required for executing
+// the current operation
+func operationScope(ctx context.Context) Scope {
+	if graphql.GetOperationContext(ctx).Operation.Operation == ast.Mutation {
+		return ScopeWrite
+	}
+
+	return ScopeRead
+}
+
+// HasScope reports whether the credentials that the authenticated user
+// has used grant scope
+func HasScope(ctx context.Context, scope Scope) bool {
+	scopes, ok := ctx.Value(authedScopesKey).([]Scope)
+	if !ok {
+		return false
+	}
+
+	return hasScope(scopes, scope)
+}
+
+func init() {
+	db.DB.AutoMigrate(&APIToken{})
+}
@@ -0,0 +1,75 @@
+package model
+
+import (
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/ryakosh/wishlist/lib"
+	"github.com/ryakosh/wishlist/lib/db"
+	"github.com/ryakosh/wishlist/lib/throttle"
+)
+
+// LoginAttempt is a table that stores failed attempts of throttled
+// operations like logging in
+type LoginAttempt struct {
+	Key         string `gorm:"primary_key;type:varchar(128)"`
+	Failures    int
+	LastFailure time.Time
+	LockedUntil time.Time
+}
+
+// AttemptStore is a throttle.Store that persists attempts in the database
+type AttemptStore struct{}
+
+// Update is used to atomically replace the attempts made for key, key's row
+// is locked so that concurrent attempts are serialized
+func (AttemptStore) Update(key string, update func(attempts *throttle.Attempts) (*throttle.Attempts, error)) error {
+	return db.DB.Transaction(func(tx *gorm.DB) error {
+		var attempt LoginAttempt
+
+		// Row is created first so that there's always a row to lock
+		d := tx.Exec("INSERT INTO login_attempts (key, failures, last_failure, locked_until) "+
+			"VALUES (?, 0, ?, ?) ON CONFLICT (key) DO NOTHING", key, time.Time{}, time.Time{})
+		if d.Error != nil {
+			lib.LogError(lib.LPanic, "Could not create login attempt", d.Error)
+		}
+
+		d = tx.Set("gorm:query_option", "FOR UPDATE").Where("key = ?", key).First(&attempt)
+		if d.Error != nil {
+			lib.LogError(lib.LPanic, "Could not read login attempt", d.Error)
+		}
+
+		attempts, err := update(&throttle.Attempts{
+			Failures:    attempt.Failures,
+			LastFailure: attempt.LastFailure,
+			LockedUntil: attempt.LockedUntil,
+		})
+		if err != nil {
+			return err
+		}
+
+		d = tx.Save(&LoginAttempt{
+			Key:         key,
+			Failures:    attempts.Failures,
+			LastFailure: attempts.LastFailure,
+			LockedUntil: attempts.LockedUntil,
+		})
+		if d.Error != nil {
+			lib.LogError(lib.LPanic, "Could not save login attempt", d.Error)
+		}
+
+		return nil
+	})
+}
+
+// Delete is used to forget the attempts made for key
+func (AttemptStore) Delete(key string) {
+	d := db.DB.Where("key = ?", key).Delete(&LoginAttempt{})
+	if d.Error != nil {
+		lib.LogError(lib.LPanic, "Could not delete login attempt", d.Error)
+	}
+}
+
+func init() {
+	db.DB.AutoMigrate(&LoginAttempt{})
+}
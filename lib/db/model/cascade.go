@@ -0,0 +1,160 @@
+package model
+
+import (
+	"fmt"
+
+	"github.com/jinzhu/gorm"
+	"github.com/ryakosh/wishlist/lib"
+	"github.com/ryakosh/wishlist/lib/db"
+)
+
+const (
+	onDeleteCascade = "CASCADE"
+	onDeleteSetNull = "SET NULL"
+)
+
+// foreignKey is a column that references users or wishes, onDelete decides
+// whether referencing rows are removed or anonymized
+type foreignKey struct {
+	table     string
+	column    string
+	refTable  string
+	refColumn string
+	onDelete  string
+}
+
+// userReferences are all the columns that reference a user, rows are
+// anonymized if they are worth keeping after the user is gone
+var userReferences = []foreignKey{
+	{"wishes", "owner", "users", "id", onDeleteCascade},
+	{"wishlists", "owner", "users", "id", onDeleteCascade},
+	{"occasions", "owner", "users", "id", onDeleteCascade},
+	{"codes", "user_id", "users", "id", onDeleteCascade},
+	{"sessions", "user_id", "users", "id", onDeleteCascade},
+	{"recovery_codes", "user_id", "users", "id", onDeleteCascade},
+	{"identities", "user_id", "users", "id", onDeleteCascade},
+	{"oidc_states", "user_id", "users", "id", onDeleteCascade},
+	{"api_tokens", "user_id", "users", "id", onDeleteCascade},
+	{"friendships", "user_id", "users", "id", onDeleteCascade},
+	{"friendships", "friend_id", "users", "id", onDeleteCascade},
+	{"friendrequests", "user_id", "users", "id", onDeleteCascade},
+	{"friendrequests", "requester_id", "users", "id", onDeleteCascade},
+	{"want_to_fulfill", "user_id", "users", "id", onDeleteCascade},
+	{"claimers", "user_id", "users", "id", onDeleteCascade},
+	{"fulfillers", "user_id", "users", "id", onDeleteCascade},
+	{"wish_audiences", "user_id", "users", "id", onDeleteCascade},
+	{"reports", "reported_user_id", "users", "id", onDeleteCascade},
+	{"reports", "reporter_id", "users", "id", onDeleteSetNull},
+	{"reports", "resolved_by", "users", "id", onDeleteSetNull},
+	{"admin_actions", "admin_id", "users", "id", onDeleteSetNull},
+}
+
+// wishReferences are all the columns that reference a wish
+var wishReferences = []foreignKey{
+	{"want_to_fulfill", "wish_id", "wishes", "id", onDeleteCascade},
+	{"claimers", "wish_id", "wishes", "id", onDeleteCascade},
+	{"fulfillers", "wish_id", "wishes", "id", onDeleteCascade},
+	{"wish_audiences", "wish_id", "wishes", "id", onDeleteCascade},
+	{"reports", "wish_id", "wishes", "id", onDeleteCascade},
+}
+
+// wishlistReferences are all the columns that reference a wishlist, wishes
+// outlive their wishlist but its occasions do not
+var wishlistReferences = []foreignKey{
+	{"wishes", "wishlist_id", "wishlists", "id", onDeleteSetNull},
+	{"occasions", "wishlist_id", "wishlists", "id", onDeleteCascade},
+}
+
+// remove is used to remove or anonymize the rows that reference
+// one of the rows in refs
+func (fk foreignKey) remove(tx *gorm.DB, op string, refs *gorm.SqlExpr) error {
+	if fk.onDelete == onDeleteSetNull {
+		return tx.Exec(fmt.Sprintf("UPDATE %s SET %s = NULL WHERE %s %s (?)",
+			fk.table, fk.column, fk.column, op), refs).Error
+	}
+
+	return tx.Exec(fmt.Sprintf("DELETE FROM %s WHERE %s %s (?)",
+		fk.table, fk.column, op), refs).Error
+}
+
+// apply is used to remove or anonymize the rows that reference one of
+// the rows of refTable that match cond
+func (fk foreignKey) apply(tx *gorm.DB, cond string, values ...interface{}) error {
+	return fk.remove(tx, "IN", tx.Table(fk.refTable).Select(fk.refColumn).Where(cond, values...).SubQuery())
+}
+
+// migrate is used to add the foreign key constraint, rows that were left
+// behind by deletions before the constraint existed are cleaned up first
+func (fk foreignKey) migrate() {
+	dest := fmt.Sprintf("%s(%s)", fk.refTable, fk.refColumn)
+	keyName := db.DB.Dialect().BuildKeyName(fk.table, fk.column, dest, "foreign")
+	if db.DB.Dialect().HasForeignKey(fk.table, keyName) {
+		return
+	}
+
+	err := fk.remove(db.DB, "NOT IN", db.DB.Table(fk.refTable).Select(fk.refColumn).SubQuery())
+	if err != nil {
+		lib.LogError(lib.LFatal, "Could not clean up '"+fk.table+"' table", err)
+	}
+
+	d := db.DB.Table(fk.table).AddForeignKey(fk.column, dest, fk.onDelete, "CASCADE")
+	if d.Error != nil {
+		lib.LogError(lib.LFatal, "Could not add foreign key to '"+fk.table+"' table", d.Error)
+	}
+}
+
+// migrateForeignKeys is used to enforce user, wish and wishlist deletion at
+// the schema level, wishes and wishlists are migrated here too as wish.go
+// and wishlist.go are initialized after user.go
+func migrateForeignKeys() {
+	db.DB.AutoMigrate(&Wish{}, &Wishlist{})
+
+	refs := append(userReferences, wishReferences...)
+	for _, fk := range append(refs, wishlistReferences...) {
+		fk.migrate()
+	}
+}
+
+// DeleteUser is used to delete the user and remove or anonymize every
+// row that references them, it should be called in a transaction
+func DeleteUser(tx *gorm.DB, username string) error {
+	// Rows that reference user's wishes go first, as the wishes are
+	// removed along with the rest of user's rows
+	for _, fk := range wishReferences {
+		err := fk.apply(tx, "owner = ?", username)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, fk := range wishlistReferences {
+		err := fk.apply(tx, "owner = ?", username)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, fk := range userReferences {
+		err := fk.apply(tx, "id = ?", username)
+		if err != nil {
+			return err
+		}
+	}
+
+	d := tx.Model(&AdminAction{}).Where("target_type = ? AND target_id = ?",
+		TargetUser, username).UpdateColumn("target_id", gorm.Expr("NULL"))
+	if d.Error != nil {
+		return d.Error
+	}
+
+	d = tx.Delete(&User{ID: username})
+	if d.Error != nil {
+		return d.Error
+	}
+
+	if d.RowsAffected == 0 {
+		return ErrUserNotFound
+	}
+
+	return nil
+}
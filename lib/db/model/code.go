@@ -18,6 +18,32 @@ const (
 	// CodeTTL is used to set code's Time-To-Live, after this duration
 	// the code is not valid anymore
 	CodeTTL = time.Minute * 30
+
+	// CodeCooldown is used to set the minimum duration a user has to wait
+	// before their active email verification code can be replaced by a new
+	// one, codes of other purposes can not be replaced until they expire
+	CodeCooldown = time.Minute * 2
+)
+
+// CodePurpose is used to indicate what a code can be spent on
+type CodePurpose string
+
+const (
+	// CodeEmailVerification is the purpose of codes that are used for
+	// verifying user's email address
+	CodeEmailVerification CodePurpose = "email_verification"
+
+	// CodePasswordReset is the purpose of codes that are used for
+	// resetting user's password
+	CodePasswordReset CodePurpose = "password_reset"
+
+	// CodeEmailChange is the purpose of codes that are used for
+	// changing user's email address
+	CodeEmailChange CodePurpose = "email_change"
+
+	// CodeAccountDeletion is the purpose of codes that are used for
+	// confirming the deletion of user's account
+	CodeAccountDeletion CodePurpose = "account_deletion"
 )
 
 var (
@@ -33,16 +59,19 @@ var (
 )
 
 // Code is a table that stores safe random codes that are
-// used for verifying emails, or when users forget their password .etc
+// used for verifying emails, or when users forget their password .etc,
+// each user can only have one active code per purpose
 type Code struct {
-	UserID     string `gorm:"primary_key"`
+	UserID     string      `gorm:"primary_key"`
+	Purpose    CodePurpose `gorm:"primary_key;type:varchar(32)"`
 	Code       string
 	RetryCount uint
 	CreatedAt  *time.Time
 }
 
 // CreateCode is used to create a new safe random code in the database
-func CreateCode(username string) (*Success, error) {
+// for the provided purpose
+func CreateCode(username string, purpose CodePurpose) (*Success, error) {
 	var user User
 	var code Code
 
@@ -56,15 +85,24 @@ func CreateCode(username string) (*Success, error) {
 		}
 	}
 
-	d = db.DB.Select("user_id, created_at").Where("user_id = ?", username).First(&code)
+	d = db.DB.Select("user_id, purpose, retry_count, created_at").Where("user_id = ? AND purpose = ?", username, purpose).First(&code)
 	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
 		lib.LogError(lib.LPanic, "Could not read code", d.Error)
 	}
 
+	// Retries of a code that has not expired are carried over to its
+	// replacement, so that resending does not allow more guesses
+	var retryCount uint
+
 	if !d.RecordNotFound() {
 		now := time.Now().UTC()
-		deadline := code.CreatedAt.UTC().Add(CodeTTL)
 
+		window := CodeTTL
+		if purpose == CodeEmailVerification {
+			window = CodeCooldown
+		}
+
+		deadline := code.CreatedAt.UTC().Add(window)
 		if !now.After(deadline) {
 			return nil, &RequestError{
 				Status: http.StatusConflict,
@@ -72,6 +110,10 @@ func CreateCode(username string) (*Success, error) {
 			}
 		}
 
+		if !now.After(code.CreatedAt.UTC().Add(CodeTTL)) {
+			retryCount = code.RetryCount
+		}
+
 		d := db.DB.Delete(&code)
 		if d.Error != nil {
 			lib.LogError(lib.LPanic, "Could not delete code", d.Error)
@@ -87,8 +129,10 @@ func CreateCode(username string) (*Success, error) {
 	}
 
 	code = Code{
-		UserID: username,
-		Code:   randCode,
+		UserID:     username,
+		Purpose:    purpose,
+		Code:       randCode,
+		RetryCount: retryCount,
 	}
 
 	d = db.DB.Create(&code)
@@ -103,11 +147,12 @@ func CreateCode(username string) (*Success, error) {
 }
 
 // VerifyCode is used to compare the provided random code by user
-// with the random code in the database
-func VerifyCode(username string, randCode string) (*Success, error) {
+// with the random code in the database that was created for the provided purpose
+func VerifyCode(username string, purpose CodePurpose, randCode string) (*Success, error) {
 	var code Code
 
-	d := db.DB.Select("user_id, code, retry_count, created_at").Where("user_id = ?", username).First(&code)
+	d := db.DB.Select("user_id, purpose, code, retry_count, created_at").Where(
+		"user_id = ? AND purpose = ?", username, purpose).First(&code)
 	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
 		lib.LogError(lib.LPanic, "Could not read code", d.Error)
 	} else if d.RecordNotFound() {
@@ -156,5 +201,14 @@ func VerifyCode(username string, randCode string) (*Success, error) {
 }
 
 func init() {
+	// Codes are short lived, so it's safe to recreate the table when
+	// it still has the old per user primary key
+	if db.DB.HasTable(&Code{}) && !db.DB.Dialect().HasColumn("codes", "purpose") {
+		d := db.DB.DropTable(&Code{})
+		if d.Error != nil {
+			lib.LogError(lib.LFatal, "Could not drop codes table", d.Error)
+		}
+	}
+
 	db.DB.AutoMigrate(&Code{})
 }
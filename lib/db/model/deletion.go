@@ -0,0 +1,116 @@
+package model
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/ryakosh/wishlist/lib"
+	"github.com/ryakosh/wishlist/lib/db"
+)
+
+// defaultDeletionGrace is the default duration between a user requesting
+// their account's deletion and the account getting purged
+const defaultDeletionGrace = time.Hour * 24 * 14
+
+// DeletionGrace is the duration that users have to cancel their account's
+// deletion by logging in, it can be configured using WISHLIST_DELETION_GRACE
+// environment variable, e.g. "72h"
+var DeletionGrace = defaultDeletionGrace
+
+// ErrUserDeletionScheduled is returned when user's account is scheduled
+// for deletion
+var ErrUserDeletionScheduled = errors.New("User is scheduled for deletion")
+
+// ScheduleDeletion is used to schedule the user's account for deletion
+// after DeletionGrace, user's sessions are revoked and returned time is
+// when the account will be purged
+func ScheduleDeletion(username string) time.Time {
+	deleteAt := time.Now().UTC().Add(DeletionGrace)
+
+	d := db.DB.Model(&User{ID: username}).Update("deletion_scheduled_at", deleteAt)
+	if d.Error != nil {
+		lib.LogError(lib.LPanic, "Could not schedule user's deletion", d.Error)
+	}
+
+	DeleteSessions(username, "")
+
+	return deleteAt
+}
+
+// CancelDeletion is used to cancel user's scheduled deletion, it reports
+// whether a deletion was scheduled, updating the row makes it wait for a
+// purge of the user that is in progress
+func CancelDeletion(user *User) bool {
+	if user.DeletionScheduledAt == nil {
+		return false
+	}
+
+	d := db.DB.Model(user).Where("deletion_scheduled_at IS NOT NULL").Update("deletion_scheduled_at", gorm.Expr("NULL"))
+	if d.Error != nil {
+		lib.LogError(lib.LPanic, "Could not cancel user's deletion", d.Error)
+	}
+
+	return d.RowsAffected != 0
+}
+
+// purgeUsers is used to delete the users whose grace period has passed,
+// errors are only logged as it's run in the background
+func purgeUsers() {
+	var users []string
+
+	d := db.DB.Model(&User{}).Where(
+		"deletion_scheduled_at <= ?", time.Now().UTC()).Pluck("id", &users)
+	if d.Error != nil {
+		lib.LogError(lib.LError, "Could not read users scheduled for deletion", d.Error)
+		return
+	}
+
+	for _, u := range users {
+		purgeUser(u)
+	}
+}
+
+func purgeUser(username string) {
+	err := db.DB.Transaction(func(tx *gorm.DB) error {
+		var user User
+
+		// User may have cancelled the deletion by logging in since they
+		// were read, the row is locked so that cancelling waits for the purge
+		d := tx.Set("gorm:query_option", "FOR UPDATE").Select("id").Where(
+			"id = ? AND deletion_scheduled_at <= ?", username, time.Now().UTC()).First(&user)
+		if d.RecordNotFound() {
+			return nil
+		} else if d.Error != nil {
+			return d.Error
+		}
+
+		return DeleteUser(tx, username)
+	})
+	if err != nil {
+		lib.LogError(lib.LError, "Could not purge user", err)
+	}
+}
+
+// PurgeScheduledDeletions deletes the users whose grace period has passed
+// every interval, it never returns so it should be run in a goroutine
+func PurgeScheduledDeletions(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for ; true; <-ticker.C {
+		purgeUsers()
+	}
+}
+
+func init() {
+	if grace := os.Getenv("WISHLIST_DELETION_GRACE"); len(grace) != 0 {
+		d, err := time.ParseDuration(grace)
+		if err != nil || d < 0 {
+			lib.LogError(lib.LFatal, "WISHLIST_DELETION_GRACE is invalid", err)
+		}
+
+		DeletionGrace = d
+	}
+}
@@ -0,0 +1,325 @@
+package model
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/ryakosh/wishlist/lib"
+	"github.com/ryakosh/wishlist/lib/db"
+)
+
+// exportTimeLayout is the layout of timestamps in CSV files
+const exportTimeLayout = time.RFC3339
+
+// Export holds all the data that the app stores about a user, it's
+// used to hand users a copy of their data
+type Export struct {
+	Profile            ExportProfile    `json:"profile"`
+	Wishes             []ExportWish     `json:"wishes"`
+	Wishlists          []ExportWishlist `json:"wishlists"`
+	Occasions          []ExportOccasion `json:"occasions"`
+	Friends            []string         `json:"friends"`
+	FriendRequests     []string         `json:"friendRequests"`     // Users that have sent a request to the user
+	SentFriendRequests []string         `json:"sentFriendRequests"` // Users that the user has sent a request to
+	WantToFulfill      []ExportWish     `json:"wantToFulfill"`
+	Claimed            []ExportWish     `json:"claimed"`
+	Fulfilled          []ExportWish     `json:"fulfilled"`
+	ExportedAt         time.Time        `json:"exportedAt"`
+}
+
+// ExportProfile is user's profile in an Export
+type ExportProfile struct {
+	ID              string     `json:"id"`
+	Email           string     `json:"email"`
+	IsEmailVerified bool       `json:"isEmailVerified"`
+	FirstName       *string    `json:"firstName"`
+	LastName        *string    `json:"lastName"`
+	CreatedAt       *time.Time `json:"createdAt"`
+}
+
+// ExportWish is a wish in an Export, it's either user's own wish or
+// a wish that the user has interacted with
+type ExportWish struct {
+	ID            int        `json:"id"`
+	Owner         string     `json:"owner"`
+	WishlistID    *int       `json:"wishlistId,omitempty"` // Only for user's own wishes
+	Name          string     `json:"name"`
+	Description   string     `json:"description"`
+	Link          string     `json:"link"`
+	Image         string     `json:"image"`
+	Quantity      int        `json:"quantity"`
+	ClaimQuantity *int       `json:"claimQuantity,omitempty"` // Only for wishes that the user has claimed or fulfilled
+	CreatedAt     *time.Time `json:"createdAt"`
+}
+
+// ExportWishlist is one of user's wishlists in an Export
+type ExportWishlist struct {
+	ID          int        `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Visibility  Visibility `json:"visibility"`
+	Position    int        `json:"position"`
+	CreatedAt   *time.Time `json:"createdAt"`
+}
+
+// ExportOccasion is one of user's occasions in an Export
+type ExportOccasion struct {
+	ID               int          `json:"id"`
+	WishlistID       *int         `json:"wishlistId"`
+	Kind             OccasionKind `json:"kind"`
+	Name             string       `json:"name"`
+	Date             time.Time    `json:"date"`
+	RemindDaysBefore int          `json:"remindDaysBefore"`
+	CreatedAt        *time.Time   `json:"createdAt"`
+}
+
+// joinedWishes returns the wishes that the user is listed in joinTable for,
+// claimed is set for tables that store the quantity of user's claim
+func joinedWishes(username string, joinTable string, claimed bool) []ExportWish {
+	wishes := []ExportWish{}
+
+	columns := "wishes.id, wishes.owner, wishes.name, wishes.description, wishes.link, wishes.image, " +
+		"wishes.quantity, wishes.created_at"
+	if claimed {
+		columns += ", " + joinTable + ".quantity AS claim_quantity"
+	}
+
+	d := db.DB.Table("wishes").Select(columns).Joins(
+		"JOIN "+joinTable+" ON "+joinTable+".wish_id = wishes.id").Where(
+		joinTable+".user_id = ?", username).Order("wishes.id").Scan(&wishes)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read user's "+joinTable, d.Error)
+	}
+
+	return wishes
+}
+
+// pluckUsers returns column of table's rows that match query
+func pluckUsers(table string, column string, query string, username string) []string {
+	users := []string{}
+
+	d := db.DB.Table(table).Where(query, username).Order(column).Pluck(column, &users)
+	if d.Error != nil {
+		lib.LogError(lib.LPanic, "Could not read user's "+table, d.Error)
+	}
+
+	return users
+}
+
+// ExportUserData is used to collect all the data that is stored about the user
+func ExportUserData(username string) (*Export, error) {
+	var user User
+	var wishes []Wish
+
+	d := db.DB.Where("id = ?", username).First(&user)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read user", d.Error)
+	} else if d.RecordNotFound() {
+		return nil, ErrUserNotFound
+	}
+
+	d = db.DB.Where("owner = ?", username).Order("id").Find(&wishes)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read user's wishes", d.Error)
+	}
+
+	wishlists := []ExportWishlist{}
+	d = db.DB.Table("wishlists").Select(
+		"id, name, description, visibility, position, created_at").Where(
+		"owner = ?", username).Order("position, id").Scan(&wishlists)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read user's wishlists", d.Error)
+	}
+
+	occasions := []ExportOccasion{}
+	d = db.DB.Table("occasions").Select(
+		"id, wishlist_id, kind, name, date, remind_days_before, created_at").Where(
+		"owner = ?", username).Order("date, id").Scan(&occasions)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read user's occasions", d.Error)
+	}
+
+	export := &Export{
+		Profile: ExportProfile{
+			ID:              user.ID,
+			Email:           user.Email,
+			IsEmailVerified: user.IsEmailVerified,
+			FirstName:       user.FirstName,
+			LastName:        user.LastName,
+			CreatedAt:       user.CreatedAt,
+		},
+		Wishes:             []ExportWish{},
+		Wishlists:          wishlists,
+		Occasions:          occasions,
+		Friends:            pluckUsers("friendships", "friend_id", "user_id = ?", username),
+		FriendRequests:     pluckUsers("friendrequests", "requester_id", "user_id = ?", username),
+		SentFriendRequests: pluckUsers("friendrequests", "user_id", "requester_id = ?", username),
+		WantToFulfill:      joinedWishes(username, "want_to_fulfill", false),
+		Claimed:            joinedWishes(username, "claimers", true),
+		Fulfilled:          joinedWishes(username, "fulfillers", true),
+		ExportedAt:         time.Now().UTC(),
+	}
+
+	for _, w := range wishes {
+		export.Wishes = append(export.Wishes, ExportWish{
+			ID:          w.ID,
+			Owner:       w.Owner,
+			WishlistID:  w.WishlistID,
+			Name:        w.Name,
+			Description: w.Description,
+			Link:        w.Link,
+			Image:       w.Image,
+			Quantity:    w.Quantity,
+			CreatedAt:   w.CreatedAt,
+		})
+	}
+
+	return export, nil
+}
+
+// JSON encodes the export as an indented JSON document
+func (e *Export) JSON() []byte {
+	data, err := json.MarshalIndent(e, "", "  ")
+	if err != nil {
+		lib.LogError(lib.LPanic, "Could not encode export", err)
+	}
+
+	return data
+}
+
+func formatExportTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.UTC().Format(exportTimeLayout)
+}
+
+func formatExportString(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
+
+func wishesCSV(wishes []ExportWish) [][]string {
+	records := [][]string{{"id", "owner", "wishlist_id", "name", "description", "link", "image",
+		"quantity", "claim_quantity", "created_at"}}
+
+	for _, w := range wishes {
+		wishlistID := ""
+		if w.WishlistID != nil {
+			wishlistID = strconv.Itoa(*w.WishlistID)
+		}
+
+		claimQuantity := ""
+		if w.ClaimQuantity != nil {
+			claimQuantity = strconv.Itoa(*w.ClaimQuantity)
+		}
+
+		records = append(records, []string{strconv.Itoa(w.ID), w.Owner, wishlistID, w.Name,
+			w.Description, w.Link, w.Image, strconv.Itoa(w.Quantity), claimQuantity, formatExportTime(w.CreatedAt)})
+	}
+
+	return records
+}
+
+func wishlistsCSV(wishlists []ExportWishlist) [][]string {
+	records := [][]string{{"id", "name", "description", "visibility", "position", "created_at"}}
+
+	for _, w := range wishlists {
+		records = append(records, []string{strconv.Itoa(w.ID), w.Name, w.Description,
+			string(w.Visibility), strconv.Itoa(w.Position),
+			formatExportTime(w.CreatedAt)})
+	}
+
+	return records
+}
+
+func usersCSV(users []string) [][]string {
+	records := [][]string{{"user_id"}}
+
+	for _, u := range users {
+		records = append(records, []string{u})
+	}
+
+	return records
+}
+
+func occasionsCSV(occasions []ExportOccasion) [][]string {
+	records := [][]string{{"id", "wishlist_id", "kind", "name", "date", "remind_days_before", "created_at"}}
+
+	for _, o := range occasions {
+		wishlistID := ""
+		if o.WishlistID != nil {
+			wishlistID = strconv.Itoa(*o.WishlistID)
+		}
+
+		records = append(records, []string{strconv.Itoa(o.ID), wishlistID, string(o.Kind), o.Name,
+			o.Date.Format("2006-01-02"), strconv.Itoa(o.RemindDaysBefore), formatExportTime(o.CreatedAt)})
+	}
+
+	return records
+}
+
+// ZIP encodes the export as a ZIP archive that contains the JSON document
+// and a CSV file for each part of the export
+func (e *Export) ZIP() []byte {
+	var buf bytes.Buffer
+
+	files := []struct {
+		name    string
+		records [][]string
+	}{
+		{"profile.csv", [][]string{
+			{"id", "email", "is_email_verified", "first_name", "last_name", "created_at"},
+			{e.Profile.ID, e.Profile.Email, strconv.FormatBool(e.Profile.IsEmailVerified),
+				formatExportString(e.Profile.FirstName), formatExportString(e.Profile.LastName),
+				formatExportTime(e.Profile.CreatedAt)},
+		}},
+		{"wishes.csv", wishesCSV(e.Wishes)},
+		{"wishlists.csv", wishlistsCSV(e.Wishlists)},
+		{"occasions.csv", occasionsCSV(e.Occasions)},
+		{"friends.csv", usersCSV(e.Friends)},
+		{"friend_requests.csv", usersCSV(e.FriendRequests)},
+		{"sent_friend_requests.csv", usersCSV(e.SentFriendRequests)},
+		{"want_to_fulfill.csv", wishesCSV(e.WantToFulfill)},
+		{"claimed.csv", wishesCSV(e.Claimed)},
+		{"fulfilled.csv", wishesCSV(e.Fulfilled)},
+	}
+
+	zw := zip.NewWriter(&buf)
+
+	w, err := zw.Create("export.json")
+	if err == nil {
+		_, err = w.Write(e.JSON())
+	}
+	if err != nil {
+		lib.LogError(lib.LPanic, "Could not write export.json", err)
+	}
+
+	for _, f := range files {
+		w, err := zw.Create(f.name)
+		if err != nil {
+			lib.LogError(lib.LPanic, "Could not create "+f.name, err)
+		}
+
+		err = csv.NewWriter(w).WriteAll(f.records)
+		if err != nil {
+			lib.LogError(lib.LPanic, "Could not write "+f.name, err)
+		}
+	}
+
+	err = zw.Close()
+	if err != nil {
+		lib.LogError(lib.LPanic, "Could not close export archive", err)
+	}
+
+	return buf.Bytes()
+}
@@ -0,0 +1,163 @@
+package model
+
+import (
+	"encoding/hex"
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/ryakosh/wishlist/lib"
+	"github.com/ryakosh/wishlist/lib/db"
+)
+
+// OIDCStateTTL is used to set the Time-To-Live of OIDC login states, users
+// must finish logging in with their identity provider within this duration
+const OIDCStateTTL = time.Minute * 10
+
+var (
+	// ErrOIDCStateNotFound is returned when OIDC login state does not exist
+	// in the database or has expired
+	ErrOIDCStateNotFound = errors.New("Login state not found")
+
+	// ErrIdentityLinked is returned when identity is already linked to a user
+	ErrIdentityLinked = errors.New("Identity is already linked")
+
+	// ErrIdentityNotFound is returned when identity does not exist in the database
+	ErrIdentityNotFound = errors.New("Identity not found")
+
+	// ErrIdentityEmailMissing is returned when identity provider does not
+	// share user's email address
+	ErrIdentityEmailMissing = errors.New("Identity provider did not share an email address")
+
+	// ErrIdentityEmailUnverified is returned when identity provider has not
+	// verified user's email address, accounts are not created for such identities
+	ErrIdentityEmailUnverified = errors.New("Identity provider has not verified the email address")
+
+	// ErrLastLoginMethod is returned when user tries to unlink the only
+	// way they can log in with
+	ErrLastLoginMethod = errors.New("Could not remove the last login method")
+)
+
+var rgxUsernameInvalidChars = regexp.MustCompile("[^a-z0-9_-]+")
+
+// Identity represents a user's account at an OpenID Connect identity
+// provider that is linked to their wishlist account
+type Identity struct {
+	Provider  string `gorm:"primary_key;type:varchar(64)"`
+	Subject   string `gorm:"primary_key;type:varchar(255)"`
+	UserID    string `gorm:"type:varchar(64);index"`
+	Email     string `gorm:"type:varchar(254)"`
+	CreatedAt *time.Time
+}
+
+// OIDCState is a table that stores the state of OIDC logins that are in
+// progress, UserID is set when an authenticated user is linking an identity
+type OIDCState struct {
+	State     string  `gorm:"primary_key;type:varchar(64)"`
+	Provider  string  `gorm:"type:varchar(64)"`
+	Nonce     string  `gorm:"type:varchar(64)"`
+	Verifier  string  `gorm:"type:varchar(128)"`
+	UserID    *string `gorm:"type:varchar(64)"`
+	CreatedAt *time.Time
+}
+
+func genOIDCRandom() string {
+	rands, _, err := lib.GenSafeRandomBytes(24)
+	if err != nil {
+		lib.LogError(lib.LPanic, "Could not generate login state", err)
+	}
+
+	return hex.EncodeToString(rands)
+}
+
+// CreateOIDCState is used to start an OIDC login, verifier is the PKCE
+// code verifier of the login
+func CreateOIDCState(provider string, verifier string, userID *string) *OIDCState {
+	state := OIDCState{
+		State:    genOIDCRandom(),
+		Provider: provider,
+		Nonce:    genOIDCRandom(),
+		Verifier: verifier,
+		UserID:   userID,
+	}
+
+	d := db.DB.Create(&state)
+	if d.Error != nil {
+		lib.LogError(lib.LPanic, "Could not create login state", d.Error)
+	}
+
+	return &state
+}
+
+// ConsumeOIDCState is used to retrieve an OIDC login state, states
+// can only be consumed once, userID must be the user that started the
+// login or nil if it was started anonymously
+func ConsumeOIDCState(state string, userID *string) (*OIDCState, error) {
+	var s OIDCState
+
+	q := db.DB.Where("state = ?", state)
+	if userID == nil {
+		q = q.Where("user_id IS NULL")
+	} else {
+		q = q.Where("user_id = ?", *userID)
+	}
+
+	d := q.First(&s)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read login state", d.Error)
+	} else if d.RecordNotFound() {
+		return nil, ErrOIDCStateNotFound
+	}
+
+	d = db.DB.Delete(&s)
+	if d.Error != nil {
+		lib.LogError(lib.LPanic, "Could not delete login state", d.Error)
+	}
+
+	now := time.Now().UTC()
+	deadline := s.CreatedAt.UTC().Add(OIDCStateTTL)
+	if now.After(deadline) {
+		return nil, ErrOIDCStateNotFound
+	}
+
+	return &s, nil
+}
+
+// AvailableUsername returns a valid username derived from base that is
+// not taken by any other user
+func AvailableUsername(base string) string {
+	var count int
+
+	username := rgxUsernameInvalidChars.ReplaceAllString(strings.ToLower(base), "")
+	if len(username) > 56 {
+		username = username[:56]
+	}
+	if username == "" {
+		username = "user"
+	}
+
+	candidate := username
+	for {
+		d := db.DB.Model(&User{}).Where("id = ?", candidate).Count(&count)
+		if d.Error != nil {
+			lib.LogError(lib.LPanic, "Could not read user", d.Error)
+		}
+
+		if count == 0 {
+			return candidate
+		}
+
+		suffix, _, err := lib.GenSafeRandomBytes(3)
+		if err != nil {
+			lib.LogError(lib.LPanic, "Could not generate username", err)
+		}
+
+		candidate = username + "-" + hex.EncodeToString(suffix)
+	}
+}
+
+func init() {
+	db.DB.AutoMigrate(&Identity{}, &OIDCState{})
+}
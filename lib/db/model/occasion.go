@@ -0,0 +1,252 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/ryakosh/wishlist/lib"
+	"github.com/ryakosh/wishlist/lib/calendar"
+	"github.com/ryakosh/wishlist/lib/db"
+)
+
+// OccasionColumns are the columns of an occasion that are exposed by the API
+const OccasionColumns = "id, owner, wishlist_id, kind, name, date, remind_days_before"
+
+// reminderWishesLimit is the maximum number of wishes that are listed in
+// a reminder
+const reminderWishesLimit = 10
+
+// nextOccurrenceSQL is the date of a yearly occasion's next occurrence on
+// or after the day given as its four parameters, it matches NextDate
+const nextOccurrenceSQL = "(CASE WHEN " +
+	"(date + make_interval(years => (EXTRACT(YEAR FROM ?::date) - EXTRACT(YEAR FROM date))::int))::date < ?::date " +
+	"THEN (date + make_interval(years => (EXTRACT(YEAR FROM ?::date) - EXTRACT(YEAR FROM date))::int + 1))::date " +
+	"ELSE (date + make_interval(years => (EXTRACT(YEAR FROM ?::date) - EXTRACT(YEAR FROM date))::int))::date END)"
+
+// OccasionKind is used to indicate what an occasion is for
+type OccasionKind string
+
+const (
+	// OccasionBirthday is user's birthday, it's repeated every year
+	OccasionBirthday OccasionKind = "birthday"
+
+	// OccasionHoliday is a holiday, it's repeated every year
+	OccasionHoliday OccasionKind = "holiday"
+
+	// OccasionCustom is any other event, it only happens once
+	OccasionCustom OccasionKind = "custom"
+)
+
+// ErrOccasionNotFound is returned when Occasion does not exist in the database
+var ErrOccasionNotFound = errors.New("Occasion not found")
+
+// Occasion is an event of a user or one of their wishlists, owner's
+// friends are reminded of owner's unclaimed wishes before it
+type Occasion struct {
+	ID               int
+	Owner            string       `gorm:"not null;index"`
+	WishlistID       *int         `gorm:"index"` // Only wishes of the wishlist are included in reminders
+	Kind             OccasionKind `gorm:"type:varchar(16);not null"`
+	Name             string       `gorm:"type:varchar(256)"`
+	Date             time.Time    `gorm:"type:date;not null"`
+	RemindDaysBefore int          `gorm:"not null;default:7"`
+	LastRemindedAt   *time.Time
+	CreatedAt        *time.Time
+	UpdatedAt        *time.Time
+}
+
+// OwnedBy implements policy.Owned
+func (o *Occasion) OwnedBy() string {
+	return o.Owner
+}
+
+// IsYearly reports whether the occasion is repeated every year
+func (o *Occasion) IsYearly() bool {
+	return o.Kind == OccasionBirthday || o.Kind == OccasionHoliday
+}
+
+// NextDate returns the date of occasion's next occurrence on or after
+// the day of now, one-off occasions always return their own date
+func (o *Occasion) NextDate(now time.Time) time.Time {
+	if !o.IsYearly() {
+		return calendar.Day(o.Date)
+	}
+
+	return calendar.Next(o.Date, now)
+}
+
+// isDue reports whether friends should be reminded of the occasion's next
+// occurrence now, friends are reminded once per occurrence
+func (o *Occasion) isDue(now time.Time) bool {
+	return calendar.IsDue(o.NextDate(now), o.RemindDaysBefore, o.LastRemindedAt, now)
+}
+
+// NextWishlistDate returns the nearest date of wishlist's occasions on or
+// after the day of now, it returns nil if none of them are upcoming
+func NextWishlistDate(wishlistID int, now time.Time) *time.Time {
+	var occasions []Occasion
+	var next *time.Time
+
+	d := db.DB.Select(OccasionColumns).Where("wishlist_id = ?", wishlistID).Find(&occasions)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read wishlist's occasions", d.Error)
+	}
+
+	today := calendar.Day(now)
+
+	for i := range occasions {
+		date := occasions[i].NextDate(now)
+		if date.Before(today) {
+			continue
+		}
+
+		if next == nil || date.Before(*next) {
+			next = &date
+		}
+	}
+
+	return next
+}
+
+// Reminder is sent to one of the occasion owner's friends before the occasion
+type Reminder struct {
+	Occasion *Occasion
+	Date     time.Time // Date of the occasion's upcoming occurrence
+	Friend   User      // Only ID and Email are set
+	Wishes   []Wish    // Owner's unclaimed wishes that friend can see
+}
+
+// ReminderSender is used to deliver reminders, e.g. by mail
+type ReminderSender func(reminder *Reminder) error
+
+// unclaimedWishes returns the occasion owner's wishes that friend can see
+// and are not completely claimed or fulfilled yet
+func unclaimedWishes(occasion *Occasion, friend string) ([]Wish, error) {
+	var wishes []Wish
+
+	d := db.DB.Scopes(VisibleWishes(friend)).Select(WishColumns).Where(
+		"owner = ? AND quantity > "+claimedQuantityExpr, occasion.Owner)
+	if occasion.WishlistID != nil {
+		d = d.Where("wishlist_id = ?", *occasion.WishlistID)
+	}
+
+	d = d.Order("position, id").Limit(reminderWishesLimit).Find(&wishes)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		return nil, d.Error
+	}
+
+	return wishes, nil
+}
+
+// remindFriends is used to send a reminder of the occasion to every
+// friend of its owner that has a verified email address and has not
+// turned occasion reminders off
+func remindFriends(occasion *Occasion, now time.Time, send ReminderSender) {
+	var friends []User
+
+	ids := db.DB.Table("friendships").Select("friend_id").Where("user_id = ?", occasion.Owner).SubQuery()
+
+	d := db.DB.Scopes(ActiveUsers).Select("id, email").Where(
+		"id IN (?) AND is_email_verified = ? AND occasion_reminders = ?", ids, true, true).Find(&friends)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LError, "Could not read occasion owner's friends", d.Error)
+		return
+	}
+
+	for _, f := range friends {
+		// Other friends are still reminded, otherwise the occasion would
+		// never be marked as reminded and everyone would be mailed again
+		wishes, err := unclaimedWishes(occasion, f.ID)
+		if err != nil {
+			lib.LogError(lib.LError, "Could not read occasion owner's wishes", err)
+			continue
+		}
+
+		// Nothing is left to be claimed by this friend
+		if len(wishes) == 0 {
+			continue
+		}
+
+		err = send(&Reminder{
+			Occasion: occasion,
+			Date:     occasion.NextDate(now),
+			Friend:   f,
+			Wishes:   wishes,
+		})
+		if err != nil {
+			lib.LogError(lib.LError, "Could not send occasion reminder", err)
+		}
+	}
+
+	d = db.DB.Model(occasion).UpdateColumn("last_reminded_at", now)
+	if d.Error != nil {
+		lib.LogError(lib.LError, "Could not update occasion", d.Error)
+	}
+}
+
+// remindOccasions is used to remind friends of the occasions that are
+// due, errors are only logged as it's run in the background
+func remindOccasions(send ReminderSender) {
+	var occasions []Occasion
+
+	now := time.Now().UTC()
+	today := now.Format("2006-01-02")
+
+	// Only occasions whose reminder window has started are read, isDue
+	// checks whether they have been reminded of already
+	d := db.DB.Scopes(ActiveOwners).Where(
+		"(kind = ? AND date >= ? AND date - remind_days_before <= ?) OR "+
+			"(kind <> ? AND "+nextOccurrenceSQL+" - remind_days_before <= ?)",
+		OccasionCustom, today, today,
+		OccasionCustom, today, today, today, today, today).Find(&occasions)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LError, "Could not read occasions", d.Error)
+		return
+	}
+
+	for i := range occasions {
+		if occasions[i].isDue(now) {
+			remindFriends(&occasions[i], now, send)
+		}
+	}
+}
+
+// RemindOccasions reminds friends of the occasions that are due every
+// interval using send, it never returns so it should be run in a goroutine
+func RemindOccasions(interval time.Duration, send ReminderSender) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for ; true; <-ticker.C {
+		remindOccasions(send)
+	}
+}
+
+// migrateEventDates is used to move the event dates that wishlists used
+// to have into one-off occasions of the wishlists, occasions are the only
+// place that dates of wishlists are stored
+func migrateEventDates() {
+	if !db.DB.Dialect().HasColumn("wishlists", "event_date") {
+		return
+	}
+
+	err := db.DB.Transaction(func(tx *gorm.DB) error {
+		d := tx.Exec("INSERT INTO occasions (owner, wishlist_id, kind, name, date, remind_days_before, created_at, updated_at) "+
+			"SELECT owner, id, ?, name, event_date, 7, now(), now() FROM wishlists WHERE event_date IS NOT NULL", OccasionCustom)
+		if d.Error != nil {
+			return d.Error
+		}
+
+		return tx.Exec("ALTER TABLE wishlists DROP COLUMN event_date").Error
+	})
+	if err != nil {
+		lib.LogError(lib.LFatal, "Could not migrate wishlists' event dates", err)
+	}
+}
+
+func init() {
+	db.DB.AutoMigrate(&Occasion{})
+
+	migrateEventDates()
+}
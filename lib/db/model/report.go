@@ -0,0 +1,37 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ryakosh/wishlist/lib/db"
+)
+
+var (
+	// ErrReportExists is returned when user has already reported the
+	// content and the report is not resolved yet
+	ErrReportExists = errors.New("Report already exists")
+
+	// ErrReportNotFound is returned when report does not exist in the database
+	ErrReportNotFound = errors.New("Report not found")
+
+	// ErrReportResolved is returned when report is already resolved
+	ErrReportResolved = errors.New("Report is already resolved")
+)
+
+// Report represents a user's complaint about abusive content, either
+// WishID or ReportedUserID is set
+type Report struct {
+	ID             int
+	ReporterID     *string `gorm:"type:varchar(64);index"` // Nil when reporter's account is deleted
+	WishID         *int    `gorm:"index"`
+	ReportedUserID *string `gorm:"type:varchar(64);index"`
+	Reason         string  `gorm:"type:varchar(1024)"`
+	ResolvedBy     *string `gorm:"type:varchar(64)"`
+	ResolvedAt     *time.Time
+	CreatedAt      *time.Time
+}
+
+func init() {
+	db.DB.AutoMigrate(&Report{})
+}
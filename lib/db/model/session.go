@@ -0,0 +1,221 @@
+package model
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/ryakosh/wishlist/lib"
+	"github.com/ryakosh/wishlist/lib/db"
+)
+
+const (
+	// SessionTTL is used to set session's Time-To-Live, a session that
+	// has not been refreshed for this duration is not valid anymore
+	SessionTTL = time.Hour * 24 * 30
+
+	maxUserAgentLength = 256
+)
+
+var (
+	// ErrSessionNotFound is returned when session does not exist in the database
+	// or the provided refresh token does not belong to any valid session
+	ErrSessionNotFound = errors.New("Session not found")
+
+	// ErrRefreshTokenMalformed is returned when the provided refresh token is malformed
+	ErrRefreshTokenMalformed = errors.New("Refresh token is malformed")
+)
+
+// Session represents a device that a user has logged in from, each session
+// holds the hash of a rotating refresh token that is used for issuing
+// short-lived access tokens
+type Session struct {
+	ID         string `gorm:"type:varchar(32)"`
+	UserID     string `gorm:"type:varchar(64);index"`
+	TokenHash  string `gorm:"type:varchar(64)"`
+	PrevHash   string `gorm:"type:varchar(64)"` // Hash of the refresh token that was rotated last
+	UserAgent  string `gorm:"type:varchar(256)"`
+	IP         string `gorm:"type:varchar(64)"`
+	LastUsedAt *time.Time
+	CreatedAt  *time.Time
+}
+
+// Tokens is a pair of access and refresh tokens issued for a session
+type Tokens struct {
+	AccessToken  string
+	RefreshToken string
+}
+
+func hashRefreshSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func genRefreshSecret() string {
+	secret, err := lib.GenRandCode(32)
+	if err != nil {
+		lib.LogError(lib.LPanic, "Could not generate refresh token", err)
+	}
+
+	return secret
+}
+
+func truncateUserAgent(userAgent string) string {
+	if len(userAgent) > maxUserAgentLength {
+		return userAgent[:maxUserAgentLength]
+	}
+
+	return userAgent
+}
+
+// CreateSession is used to create a new session for the user and issue
+// it's first pair of tokens
+func CreateSession(user *User, userAgent string, ip string) *Tokens {
+	id, _, err := lib.GenSafeRandomBytes(16)
+	if err != nil {
+		lib.LogError(lib.LPanic, "Could not generate session id", err)
+	}
+
+	now := time.Now().UTC()
+	secret := genRefreshSecret()
+	session := Session{
+		ID:         hex.EncodeToString(id),
+		UserID:     user.ID,
+		TokenHash:  hashRefreshSecret(secret),
+		UserAgent:  truncateUserAgent(userAgent),
+		IP:         ip,
+		LastUsedAt: &now,
+	}
+
+	d := db.DB.Create(&session)
+	if d.Error != nil {
+		lib.LogError(lib.LPanic, "Could not create session", d.Error)
+	}
+
+	return &Tokens{
+		AccessToken:  lib.Encode(user.ID, user.Email, session.ID),
+		RefreshToken: session.ID + "." + secret,
+	}
+}
+
+// RefreshSession is used to rotate session's refresh token and issue a new
+// pair of tokens, presenting an already rotated refresh token revokes the
+// whole session as it's an indication of the token being stolen
+func RefreshSession(refreshToken string, userAgent string, ip string) (*Tokens, error) {
+	var session Session
+	var user User
+
+	parts := strings.Split(refreshToken, ".")
+	if len(parts) != 2 {
+		return nil, ErrRefreshTokenMalformed
+	}
+
+	d := db.DB.Where("id = ?", parts[0]).First(&session)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read session", d.Error)
+	} else if d.RecordNotFound() {
+		return nil, ErrSessionNotFound
+	}
+
+	now := time.Now().UTC()
+	deadline := session.LastUsedAt.UTC().Add(SessionTTL)
+	hash := hashRefreshSecret(parts[1])
+
+	// A rotated token that is used again has been stolen, so the session
+	// is revoked, other wrong tokens are rejected without revoking it as
+	// session IDs are not secret
+	reused := session.PrevHash != "" && subtle.ConstantTimeCompare([]byte(hash), []byte(session.PrevHash)) == 1
+	if now.After(deadline) || reused {
+		d := db.DB.Delete(&session)
+		if d.Error != nil {
+			lib.LogError(lib.LPanic, "Could not delete session", d.Error)
+		}
+
+		return nil, ErrSessionNotFound
+	} else if subtle.ConstantTimeCompare([]byte(hash), []byte(session.TokenHash)) != 1 {
+		return nil, ErrSessionNotFound
+	}
+
+	d = db.DB.Select("id, email, suspended_at, deletion_scheduled_at").Where("id = ?", session.UserID).First(&user)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read user", d.Error)
+	} else if d.RecordNotFound() {
+		return nil, ErrUserNotFound
+	}
+
+	if err := user.StatusError(); err != nil {
+		return nil, err
+	}
+
+	// Rotation only succeeds if the token was not rotated by a concurrent
+	// refresh in the meantime, otherwise the token was used twice
+	secret := genRefreshSecret()
+	d = db.DB.Model(&session).Where("token_hash = ?", session.TokenHash).Updates(map[string]interface{}{
+		"token_hash":   hashRefreshSecret(secret),
+		"prev_hash":    session.TokenHash,
+		"user_agent":   truncateUserAgent(userAgent),
+		"ip":           ip,
+		"last_used_at": now,
+	})
+	if d.Error != nil {
+		lib.LogError(lib.LPanic, "Could not update session", d.Error)
+	}
+
+	if d.RowsAffected == 0 {
+		d := db.DB.Delete(&session)
+		if d.Error != nil {
+			lib.LogError(lib.LPanic, "Could not delete session", d.Error)
+		}
+
+		return nil, ErrSessionNotFound
+	}
+
+	return &Tokens{
+		AccessToken:  lib.Encode(user.ID, user.Email, session.ID),
+		RefreshToken: session.ID + "." + secret,
+	}, nil
+}
+
+// DeleteSession is used to revoke one of user's sessions
+func DeleteSession(username string, sessionID string) error {
+	d := db.DB.Where("id = ? AND user_id = ?", sessionID, username).Delete(&Session{})
+	if d.Error != nil {
+		lib.LogError(lib.LPanic, "Could not delete session", d.Error)
+	}
+
+	if d.RowsAffected == 0 {
+		return ErrSessionNotFound
+	}
+
+	return nil
+}
+
+// DeleteSessions is used to revoke all of user's sessions except the
+// one with the provided id, pass an empty string to revoke them all
+func DeleteSessions(username string, except string) {
+	d := db.DB.Where("user_id = ? AND id <> ?", username, except).Delete(&Session{})
+	if d.Error != nil {
+		lib.LogError(lib.LPanic, "Could not delete sessions", d.Error)
+	}
+}
+
+// SessionExists reports whether the session is still valid and belongs to the user
+func SessionExists(username string, sessionID string) bool {
+	var count int
+
+	d := db.DB.Model(&Session{}).Where("id = ? AND user_id = ?", sessionID, username).Count(&count)
+	if d.Error != nil {
+		lib.LogError(lib.LPanic, "Could not read session", d.Error)
+	}
+
+	return count == 1
+}
+
+func init() {
+	db.DB.AutoMigrate(&Session{})
+}
@@ -0,0 +1,172 @@
+package model
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/ryakosh/wishlist/lib"
+	"github.com/ryakosh/wishlist/lib/db"
+)
+
+const (
+	// RecoveryCodesCount is the number of recovery codes that are generated
+	// when two-factor authentication is activated
+	RecoveryCodesCount = 10
+
+	// recoveryCodeBytes is the number of random bytes in a recovery code,
+	// codes are hex encoded so they are twice as long
+	recoveryCodeBytes = 10
+)
+
+var (
+	// ErrTwoFactorEnabled is returned when user's two-factor authentication
+	// is already enabled
+	ErrTwoFactorEnabled = errors.New("Two-factor authentication is already enabled")
+
+	// ErrTwoFactorNotEnabled is returned when user tries to disable
+	// two-factor authentication that is not enabled
+	ErrTwoFactorNotEnabled = errors.New("Two-factor authentication is not enabled")
+
+	// ErrTwoFactorNotSetUp is returned when user tries to confirm two-factor
+	// authentication before enabling it
+	ErrTwoFactorNotSetUp = errors.New("Two-factor authentication is not set up")
+
+	// ErrTwoFactorCodeInvalid is returned when the provided TOTP or
+	// recovery code is not valid
+	ErrTwoFactorCodeInvalid = errors.New("Two-factor code is invalid")
+)
+
+// RecoveryCode is a table that stores salted hashes of single use codes
+// that can be used instead of TOTP codes when users lose their device
+type RecoveryCode struct {
+	ID     int
+	UserID string `gorm:"type:varchar(64);index"`
+	Salt   string `gorm:"type:varchar(32)"` // Empty for codes that were stored unsalted
+	Hash   string `gorm:"type:varchar(64)"`
+}
+
+func hashRecoveryCode(salt string, code string) string {
+	sum := sha256.Sum256([]byte(salt + strings.ToLower(code)))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// GenRecoveryCodes is used to replace user's recovery codes with
+// new ones, the codes are only returned once and stored hashed
+func GenRecoveryCodes(username string) []string {
+	var codes []string
+
+	d := db.DB.Where("user_id = ?", username).Delete(&RecoveryCode{})
+	if d.Error != nil {
+		lib.LogError(lib.LPanic, "Could not delete recovery codes", d.Error)
+	}
+
+	for i := 0; i < RecoveryCodesCount; i++ {
+		rands, _, err := lib.GenSafeRandomBytes(recoveryCodeBytes)
+		if err != nil {
+			lib.LogError(lib.LPanic, "Could not generate recovery code", err)
+		}
+
+		salt, _, err := lib.GenSafeRandomBytes(16)
+		if err != nil {
+			lib.LogError(lib.LPanic, "Could not generate recovery code", err)
+		}
+
+		code := hex.EncodeToString(rands)
+		d := db.DB.Create(&RecoveryCode{
+			UserID: username,
+			Salt:   hex.EncodeToString(salt),
+			Hash:   hashRecoveryCode(hex.EncodeToString(salt), code),
+		})
+		if d.Error != nil {
+			lib.LogError(lib.LPanic, "Could not create recovery code", d.Error)
+		}
+
+		codes = append(codes, code)
+	}
+
+	return codes
+}
+
+// VerifyTwoFactor reports whether code is a valid TOTP code or one of
+// user's recovery codes, used recovery codes and TOTP time steps can not
+// be used again, user must have ID, TwoFactorSecret and TwoFactorLastStep
+func VerifyTwoFactor(user *User, code string) bool {
+	if user.TwoFactorSecret == nil {
+		return false
+	}
+
+	step, ok := lib.VerifyTOTP(*user.TwoFactorSecret, code, time.Now().UTC())
+	if ok {
+		if step <= user.TwoFactorLastStep {
+			return false
+		}
+
+		// Step is only taken if a concurrent request has not taken it first
+		d := db.DB.Model(user).Where("two_factor_last_step < ?", step).Update("two_factor_last_step", step)
+		if d.Error != nil {
+			lib.LogError(lib.LPanic, "Could not update user", d.Error)
+		}
+
+		return d.RowsAffected == 1
+	}
+
+	return useRecoveryCode(user.ID, code)
+}
+
+// useRecoveryCode reports whether code is one of user's recovery codes and
+// deletes it, codes are salted so each of them is hashed and compared
+func useRecoveryCode(username string, code string) bool {
+	var recoveryCodes []RecoveryCode
+
+	d := db.DB.Select("id, salt, hash").Where("user_id = ?", username).Find(&recoveryCodes)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read recovery codes", d.Error)
+	}
+
+	for _, rc := range recoveryCodes {
+		hash := hashRecoveryCode(rc.Salt, code)
+		if subtle.ConstantTimeCompare([]byte(hash), []byte(rc.Hash)) != 1 {
+			continue
+		}
+
+		// Code is only used if a concurrent request has not used it first
+		d := db.DB.Delete(&RecoveryCode{ID: rc.ID})
+		if d.Error != nil {
+			lib.LogError(lib.LPanic, "Could not delete recovery code", d.Error)
+		}
+
+		return d.RowsAffected == 1
+	}
+
+	return false
+}
+
+// DisableTwoFactor is used to turn off user's two-factor authentication,
+// user's TOTP secret and recovery codes are removed
+func DisableTwoFactor(username string) {
+	err := db.DB.Transaction(func(tx *gorm.DB) error {
+		d := tx.Model(&User{ID: username}).Updates(map[string]interface{}{
+			"is_two_factor_enabled": false,
+			"two_factor_secret":     gorm.Expr("NULL"),
+			"two_factor_last_step":  0,
+		})
+		if d.Error != nil {
+			return d.Error
+		}
+
+		return tx.Where("user_id = ?", username).Delete(&RecoveryCode{}).Error
+	})
+	if err != nil {
+		lib.LogError(lib.LPanic, "Could not disable two-factor authentication", err)
+	}
+}
+
+func init() {
+	db.DB.AutoMigrate(&RecoveryCode{})
+}
@@ -2,7 +2,11 @@ package model
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
+	"fmt"
+	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -11,17 +15,22 @@ import (
 	"github.com/jinzhu/gorm"
 	"github.com/ryakosh/wishlist/lib"
 	"github.com/ryakosh/wishlist/lib/db"
+	"golang.org/x/crypto/argon2"
 )
 
 type key int
 
 const (
 	authedUserKey key = iota
+	authedSessionKey
+	authedScopesKey
+	authedRoleKey
 )
 
 const (
 	UserWishesAsso         db.Association = "Wishes"
-	UserCodeAsso           db.Association = "Code"
+	UserWishlistsAsso      db.Association = "Wishlists"
+	UserCodesAsso          db.Association = "Codes"
 	UserFriendsAsso        db.Association = "Friends"
 	UserFriendRequestsAsso db.Association = "FriendRequests"
 )
@@ -53,6 +62,9 @@ var (
 	ErrEmailVerified = errors.New("Email is already verified")
 )
 
+// argonConfig holds the parameters used for hashing passwords, memory (in KiB),
+// iterations and parallelism can be configured using WISHLIST_ARGON_MEMORY,
+// WISHLIST_ARGON_ITERATIONS and WISHLIST_ARGON_PARALLELISM environment variables
 var argonConfig = &argon2id.Params{
 	Memory:      32 * 1024,
 	Iterations:  2,
@@ -61,38 +73,71 @@ var argonConfig = &argon2id.Params{
 	KeyLength:   32,
 }
 
+// dummyHash is generated using the configured argon2id parameters
+// and is only used by VerifyDummyPassword
+var dummyHash string
+
 // User represents a user in the app
 type User struct {
-	ID              string `gorm:"type:varchar(64)"`
-	Email           string `gorm:"type:varchar(254);unique"`
-	IsEmailVerified bool
-	Password        string  `gorm:"type:varchar(256)"`
-	FirstName       *string `gorm:"type:varchar(64)"`
-	LastName        *string `gorm:"type:varchar(64)"`
-	Wishes          []Wish  `gorm:"foreignkey:Owner"`
-	Code            Code
-	Friends         []*User `gorm:"many2many:friendships;association_jointable_foreignkey:friend_id"`
-	FriendRequests  []*User `gorm:"many2many:friendrequests;association_jointable_foreignkey:requester_id"`
-	CreatedAt       *time.Time
-	UpdatedAt       *time.Time
+	ID                  string `gorm:"type:varchar(64)"`
+	Email               string `gorm:"type:varchar(254);unique"`
+	IsEmailVerified     bool
+	PendingEmail        *string `gorm:"type:varchar(254)"`
+	Password            string  `gorm:"type:varchar(256)"`
+	TwoFactorSecret     *string `gorm:"type:varchar(64)"`
+	TwoFactorLastStep   int64
+	IsTwoFactorEnabled  bool
+	Role                Role `gorm:"type:varchar(16);not null;default:'user'"`
+	SuspendedAt         *time.Time
+	DeletionScheduledAt *time.Time
+	FirstName           *string    `gorm:"type:varchar(64)"`
+	LastName            *string    `gorm:"type:varchar(64)"`
+	OccasionReminders   bool       `gorm:"not null;default:true"` // Whether user is mailed about friends' occasions
+	Wishes              []Wish     `gorm:"foreignkey:Owner"`
+	Wishlists           []Wishlist `gorm:"foreignkey:Owner"`
+	Codes               []Code
+	Sessions            []Session
+	RecoveryCodes       []RecoveryCode
+	Identities          []Identity
+	APITokens           []APIToken
+	Friends             []*User `gorm:"many2many:friendships;association_jointable_foreignkey:friend_id"`
+	FriendRequests      []*User `gorm:"many2many:friendrequests;association_jointable_foreignkey:requester_id"`
+	CreatedAt           *time.Time
+	UpdatedAt           *time.Time
 }
 
-// AfterDelete is used to clean up after the user got deleted
-func (u *User) AfterDelete(tx *gorm.DB) error {
-	d := db.DB.Where("user_id = ?", u.ID).Delete(&Wish{})
-	if d.Error != nil {
-		lib.LogError(lib.LPanic, "Could not delete user's wishes", d.Error)
-	}
+// inactiveUserCond matches the users that can not use the app anymore
+const inactiveUserCond = "(suspended_at IS NOT NULL OR deletion_scheduled_at IS NOT NULL)"
 
-	d = db.DB.Where("user_id = ?", u.ID).Delete(&Code{})
-	if d.Error != nil {
-		lib.LogError(lib.LPanic, "Could not delete user's code", d.Error)
+// IsActive reports whether the user can use the app, suspended users and
+// users that are scheduled for deletion are not active
+func (u *User) IsActive() bool {
+	return u.StatusError() == nil
+}
 
+// StatusError returns the reason that the user is not active for
+func (u *User) StatusError() error {
+	if u.SuspendedAt != nil {
+		return ErrUserSuspended
+	} else if u.DeletionScheduledAt != nil {
+		return ErrUserDeletionScheduled
 	}
 
 	return nil
 }
 
+// ActiveUsers is a scope that excludes users that are not active, their
+// profiles should not be visible to others
+func ActiveUsers(d *gorm.DB) *gorm.DB {
+	return d.Where("NOT (" + inactiveUserCond + ")")
+}
+
+// ActiveOwners is a scope that excludes wishes, wishlists or occasions
+// that belong to users that are not active
+func ActiveOwners(d *gorm.DB) *gorm.DB {
+	return d.Where("owner NOT IN (?)", db.DB.Table("users").Select("id").Where(inactiveUserCond).SubQuery())
+}
+
 func GenPasswordHash(password string) string {
 	hash, err := argon2id.CreateHash(password, argonConfig)
 	if err != nil {
@@ -102,7 +147,60 @@ func GenPasswordHash(password string) string {
 	return hash
 }
 
+// NeedsRehash reports whether the hash was generated using a different
+// argon2 version or parameters than the ones currently in use
+func NeedsRehash(hash string) bool {
+	var version int
+	var memory, iterations uint32
+	var parallelism uint8
+
+	vals := strings.Split(hash, "$")
+	if len(vals) != 6 {
+		return true
+	}
+
+	_, err := fmt.Sscanf(vals[2], "v=%d", &version)
+	if err != nil {
+		return true
+	}
+
+	_, err = fmt.Sscanf(vals[3], "m=%d,t=%d,p=%d", &memory, &iterations, &parallelism)
+	if err != nil {
+		return true
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(vals[4])
+	if err != nil {
+		return true
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(vals[5])
+	if err != nil {
+		return true
+	}
+
+	return version != argon2.Version ||
+		memory != argonConfig.Memory ||
+		iterations != argonConfig.Iterations ||
+		parallelism != argonConfig.Parallelism ||
+		uint32(len(salt)) != argonConfig.SaltLength ||
+		uint32(len(key)) != argonConfig.KeyLength
+}
+
+// VerifyDummyPassword is used to spend the same amount of time as
+// VerifyPassword when there's no user to verify the password against,
+// so that response times do not reveal which accounts exist
+func VerifyDummyPassword(password string) {
+	VerifyPassword(password, dummyHash)
+}
+
 func VerifyPassword(password string, hash string) bool {
+	// Users that have signed up using an identity provider do not have a password
+	if hash == "" {
+		VerifyDummyPassword(password)
+		return false
+	}
+
 	isMatch, err := argon2id.ComparePasswordAndHash(password, hash)
 	if err != nil {
 		lib.LogError(lib.LPanic, "Could not verify password", err)
@@ -126,6 +224,8 @@ func AreFriends(user, authedUser string) bool {
 // they require authentication or not, however it aborts requests if
 // the provided token is malformed, expired or not valid
 func AuthRequired(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+	var user User
+
 	authedUser := AuthedUserFromCtx(ctx)
 	if authedUser != "" {
 		return authedUser, nil
@@ -142,29 +242,73 @@ func AuthRequired(ctx context.Context, obj interface{}, next graphql.Resolver) (
 		return nil, ErrBearerTokenMalformed
 	}
 
-	claims, valid, err := lib.Decode(token[1])
-	if err == nil && valid {
-		var user User
-		sub := claims["sub"]
+	if strings.HasPrefix(token[1], APITokenPrefix) {
+		apiToken, err := VerifyAPIToken(token[1])
+		if err != nil {
+			return nil, err
+		}
 
-		d := db.DB.Select("id").Where("id = ? AND email = ?", sub, claims["email"]).First(&user)
+		d := db.DB.Select("id, role, suspended_at, deletion_scheduled_at").Where("id = ?", apiToken.UserID).First(&user)
 		if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
 			lib.LogError(lib.LPanic, "Could not read user", d.Error)
 		} else if d.RecordNotFound() {
 			return nil, ErrUserNotFound
 		}
 
-		authedUser = sub.(string)
-		ctx = context.WithValue(ctx, authedUserKey, authedUser)
+		if err := user.StatusError(); err != nil {
+			return nil, err
+		}
+
+		scopes := apiToken.ScopeList()
+		if !hasScope(scopes, operationScope(ctx)) {
+			return nil, ErrInsufficientScope
+		}
+
+		ctx = context.WithValue(ctx, authedUserKey, apiToken.UserID)
+		ctx = context.WithValue(ctx, authedScopesKey, scopes)
+		ctx = context.WithValue(ctx, authedRoleKey, user.Role)
 
 		return next(ctx)
-	} else if lib.IsMalformed(err) {
-		return nil, lib.ErrTokenIsMalformed
-	} else if lib.HasExpired(err) {
-		return nil, lib.ErrTokenHasExpired
-	} else {
-		return nil, lib.ErrTokenIsInvalid
 	}
+
+	claims, err := lib.Decode(token[1])
+	if err != nil {
+		return nil, err
+	}
+
+	d := db.DB.Select("id, role, suspended_at, deletion_scheduled_at").Where("id = ? AND email = ?", claims.Subject, claims.Email).First(&user)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read user", d.Error)
+	} else if d.RecordNotFound() {
+		return nil, ErrUserNotFound
+	}
+
+	if err := user.StatusError(); err != nil {
+		return nil, err
+	}
+
+	if !SessionExists(claims.Subject, claims.SessionID) {
+		return nil, ErrSessionNotFound
+	}
+
+	ctx = context.WithValue(ctx, authedUserKey, claims.Subject)
+	ctx = context.WithValue(ctx, authedSessionKey, claims.SessionID)
+	ctx = context.WithValue(ctx, authedScopesKey, sessionScopes)
+	ctx = context.WithValue(ctx, authedRoleKey, user.Role)
+
+	return next(ctx)
+}
+
+// AuthOptional is a middleware that authenticates users the same way
+// AuthRequired does, but lets requests without Authorization header
+// through anonymously
+func AuthOptional(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+	c := lib.GinCtxFromCtx(ctx)
+	if c.GetHeader("Authorization") == "" {
+		return next(ctx)
+	}
+
+	return AuthRequired(ctx, obj, next)
 }
 
 func AuthedUserFromCtx(ctx context.Context) string {
@@ -181,6 +325,38 @@ func AuthedUserFromCtx(ctx context.Context) string {
 	return c
 }
 
+// AuthedRoleFromCtx returns the role of the authenticated user, it's read
+// once per request when the user is authenticated
+func AuthedRoleFromCtx(ctx context.Context) Role {
+	authedRole := ctx.Value(authedRoleKey)
+	if authedRole == nil {
+		return ""
+	}
+
+	r, ok := authedRole.(Role)
+	if !ok {
+		lib.LogError(lib.LFatal, "AuthedRole has wrong type", nil)
+	}
+
+	return r
+}
+
+// AuthedSessionFromCtx returns the id of the session that the authenticated
+// user's access token was issued for
+func AuthedSessionFromCtx(ctx context.Context) string {
+	authedSession := ctx.Value(authedSessionKey)
+	if authedSession == nil {
+		return ""
+	}
+
+	c, ok := authedSession.(string)
+	if !ok {
+		lib.LogError(lib.LFatal, "AuthedSession has wrong type", nil)
+	}
+
+	return c
+}
+
 // EmailVerificationRequired is a middleware that is used to
 // indicate that a user's email address must be verified in order
 // to access this endpoint, it should be called after the Authenticate
@@ -201,6 +377,39 @@ func EmailVerificationRequired(ctx context.Context, obj interface{}, next graphq
 	return next(ctx)
 }
 
+// argonParamFromEnv is used to override one of argon2id parameters using
+// the provided environment variable
+func argonParamFromEnv(env string, bitSize int) (uint64, bool) {
+	val := os.Getenv(env)
+	if len(val) == 0 {
+		return 0, false
+	}
+
+	param, err := strconv.ParseUint(val, 10, bitSize)
+	if err != nil || param == 0 {
+		lib.LogError(lib.LFatal, "'"+env+"' must be a positive integer", err)
+	}
+
+	return param, true
+}
+
 func init() {
+	if memory, ok := argonParamFromEnv("WISHLIST_ARGON_MEMORY", 32); ok {
+		argonConfig.Memory = uint32(memory)
+	}
+
+	if iterations, ok := argonParamFromEnv("WISHLIST_ARGON_ITERATIONS", 32); ok {
+		argonConfig.Iterations = uint32(iterations)
+	}
+
+	if parallelism, ok := argonParamFromEnv("WISHLIST_ARGON_PARALLELISM", 8); ok {
+		argonConfig.Parallelism = uint8(parallelism)
+	}
+
+	dummyHash = GenPasswordHash("wishlist-dummy-password")
+
 	db.DB.AutoMigrate(&User{})
+
+	migrateForeignKeys()
+	promoteAdmins()
 }
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"time"
 
+	"github.com/jinzhu/gorm"
+	"github.com/ryakosh/wishlist/lib"
 	"github.com/ryakosh/wishlist/lib/db"
 )
 
@@ -11,19 +13,70 @@ const (
 	WishWantToFulfillAsso db.Association = "WantToFulfill"
 	WishClaimersAsso      db.Association = "Claimers"
 	WishFulFillersAsso    db.Association = "Fulfillers"
+	WishAudienceAsso      db.Association = "Audience"
 )
 
-// ErrWishNotFound is returned when Wish does not exist in the database
-var ErrWishNotFound = errors.New("Wish not found")
+// WishColumns are the columns of a wish that are exposed by the API
+const WishColumns = "id, owner, name, description, link, image, visibility, surprise, reveal_at, wishlist_id, position, quantity"
+
+// Visibility is used to indicate who can see a wish
+type Visibility string
+
+const (
+	// VisibilityPublic wishes can be seen by anyone
+	VisibilityPublic Visibility = "public"
+
+	// VisibilityFriends wishes can only be seen by owner's friends
+	VisibilityFriends Visibility = "friends"
+
+	// VisibilitySelected wishes can only be seen by the friends that
+	// owner has added to wish's audience
+	VisibilitySelected Visibility = "selected"
+
+	// VisibilityPrivate wishes can only be seen by their owner
+	VisibilityPrivate Visibility = "private"
+)
+
+var (
+	// ErrWishNotFound is returned when Wish does not exist in the database
+	ErrWishNotFound = errors.New("Wish not found")
+
+	// ErrAudienceNotFriend is returned when wish's audience contains
+	// users that are not friends with wish's owner
+	ErrAudienceNotFriend = errors.New("Audience must only contain friends")
+
+	// ErrWishConcealed is returned when wish's owner tries to manage
+	// claims of a wish that is in surprise mode and is not revealed yet
+	ErrWishConcealed = errors.New("Wish is in surprise mode")
+
+	// ErrWishNotSurprise is returned when an action is only available
+	// for wishes that are in surprise mode
+	ErrWishNotSurprise = errors.New("Wish is not in surprise mode")
+
+	// ErrQuantityExceeded is returned when a claim's quantity is more
+	// than wish's remaining quantity
+	ErrQuantityExceeded = errors.New("Quantity exceeds wish's remaining quantity")
+
+	// ErrQuantityBelowClaimed is returned when wish's quantity is set to
+	// less than the quantity that is already claimed or fulfilled
+	ErrQuantityBelowClaimed = errors.New("Quantity is less than the claimed quantity")
+)
 
 // Wish represents a user's wish to buy something, do something etc.
 type Wish struct {
 	ID            int
 	Owner         string
+	WishlistID    *int   `gorm:"index"`
+	Position      int    `gorm:"not null;default:0"` // Order of the wish in its wishlist
 	Name          string `gorm:"type:varchar(256)"`
 	Description   string `gorm:"type:varchar(1024)"`
 	Link          string
 	Image         string
+	Quantity      int        `gorm:"not null;default:1"`
+	Visibility    Visibility `gorm:"type:varchar(16);not null;default:'public'"`
+	Surprise      bool       `gorm:"not null;default:false"` // Hide claims from owner until RevealAt
+	RevealAt      *time.Time
+	Audience      []User `gorm:"many2many:wish_audiences"`
 	WantToFulfill []User `gorm:"many2many:want_to_fulfill"`
 	Claimers      []User `gorm:"many2many:claimers"`
 	Fulfillers    []User `gorm:"many2many:fulfillers"`
@@ -31,6 +84,189 @@ type Wish struct {
 	UpdatedAt     *time.Time
 }
 
+// OwnedBy implements policy.Owned
+func (w *Wish) OwnedBy() string {
+	return w.Owner
+}
+
+// claimTables are the join tables of wish's associations to the users
+// that are fulfilling it
+var claimTables = map[db.Association]string{
+	WishWantToFulfillAsso: "want_to_fulfill",
+	WishClaimersAsso:      "claimers",
+	WishFulFillersAsso:    "fulfillers",
+}
+
+// claim is a row of claimers or fulfillers join tables, it's used to add
+// quantity column to the tables that gorm creates for associations
+type claim struct {
+	WishID   int    `gorm:"primary_key;auto_increment:false"`
+	UserID   string `gorm:"primary_key"`
+	Quantity int    `gorm:"not null;default:1"`
+}
+
+// claimedQuantityExpr is the quantity of a wish that is claimed or
+// fulfilled, it's used in queries of wishes table
+const claimedQuantityExpr = "(SELECT COALESCE(SUM(claimers.quantity), 0) FROM claimers WHERE claimers.wish_id = wishes.id) + " +
+	"(SELECT COALESCE(SUM(fulfillers.quantity), 0) FROM fulfillers WHERE fulfillers.wish_id = wishes.id)"
+
+// ClaimedQuantity returns the quantity of the wish that is claimed or fulfilled
+func ClaimedQuantity(tx *gorm.DB, wishID int) int {
+	var claimed int
+
+	err := tx.Table("wishes").Select(claimedQuantityExpr).Where("id = ?", wishID).Row().Scan(&claimed)
+	if err != nil {
+		lib.LogError(lib.LPanic, "Could not read wish's claimed quantity", err)
+	}
+
+	return claimed
+}
+
+// RemainingQuantity returns the quantity of the wish that nobody has
+// claimed or fulfilled yet
+func RemainingQuantity(tx *gorm.DB, wish *Wish) int {
+	remaining := wish.Quantity - ClaimedQuantity(tx, wish.ID)
+	if remaining < 0 {
+		return 0
+	}
+
+	return remaining
+}
+
+// ClaimQuantity returns the quantity of the wish that user has claimed or
+// fulfilled, asso must be either WishClaimersAsso or WishFulFillersAsso
+func ClaimQuantity(tx *gorm.DB, asso db.Association, wishID int, user string) int {
+	var quantity int
+
+	err := tx.Table(claimTables[asso]).Select("COALESCE(SUM(quantity), 0)").Where(
+		"wish_id = ? AND user_id = ?", wishID, user).Row().Scan(&quantity)
+	if err != nil {
+		lib.LogError(lib.LPanic, "Could not read claim's quantity", err)
+	}
+
+	return quantity
+}
+
+// MoveClaim is used to move user's claim of the wish from one association
+// to another, quantity is added to user's existing claim in to and is
+// ignored for WishWantToFulfillAsso, it should be called in a transaction,
+// ErrUserNotFound is returned if user is not in from
+func MoveClaim(tx *gorm.DB, wishID int, user string, from db.Association, to db.Association, quantity int) error {
+	d := tx.Exec("DELETE FROM "+claimTables[from]+" WHERE wish_id = ? AND user_id = ?", wishID, user)
+	if d.Error != nil {
+		return d.Error
+	}
+
+	// A concurrent request has already moved the claim
+	if d.RowsAffected == 0 {
+		return ErrUserNotFound
+	}
+
+	if to == WishWantToFulfillAsso {
+		return tx.Model(&Wish{ID: wishID}).Association(string(to)).Append(&User{ID: user}).Error
+	}
+
+	d = tx.Exec("UPDATE "+claimTables[to]+" SET quantity = quantity + ? WHERE wish_id = ? AND user_id = ?",
+		quantity, wishID, user)
+	if d.Error != nil || d.RowsAffected != 0 {
+		return d.Error
+	}
+
+	return tx.Exec("INSERT INTO "+claimTables[to]+" (wish_id, user_id, quantity) VALUES (?, ?, ?)",
+		wishID, user, quantity).Error
+}
+
+// Concealed reports whether wish's claims are hidden from its owner,
+// surprise wishes without RevealAt are never revealed
+func (w *Wish) Concealed() bool {
+	return w.Surprise && (w.RevealAt == nil || time.Now().Before(*w.RevealAt))
+}
+
+// IsReserved reports whether anyone has claimed or fulfilled the wish
+func IsReserved(wishID int) bool {
+	var count int
+
+	d := db.DB.Table("claimers").Where("wish_id = ?", wishID).Count(&count)
+	if d.Error != nil {
+		lib.LogError(lib.LPanic, "Could not read wish's claimers", d.Error)
+	}
+	if count != 0 {
+		return true
+	}
+
+	d = db.DB.Table("fulfillers").Where("wish_id = ?", wishID).Count(&count)
+	if d.Error != nil {
+		lib.LogError(lib.LPanic, "Could not read wish's fulfillers", d.Error)
+	}
+
+	return count != 0
+}
+
+// visibleWishlistIDs returns a subquery of the wishlists that viewer can see
+func visibleWishlistIDs(viewer string) *gorm.SqlExpr {
+	return db.DB.Table("wishlists").Select("id").Scopes(VisibleWishlists(viewer)).SubQuery()
+}
+
+// VisibleWishes returns a scope that excludes the wishes that viewer can
+// not see, wishes in wishlists that viewer can not see are excluded too,
+// viewer is empty for anonymous users
+func VisibleWishes(viewer string) func(*gorm.DB) *gorm.DB {
+	return func(d *gorm.DB) *gorm.DB {
+		if viewer == "" {
+			return d.Where("wishes.visibility = ? AND (wishes.wishlist_id IS NULL OR wishes.wishlist_id IN (?))",
+				VisibilityPublic, visibleWishlistIDs(viewer))
+		}
+
+		friends := db.DB.Table("friendships").Select("friend_id").Where("user_id = ?", viewer).SubQuery()
+		audiences := db.DB.Table("wish_audiences").Select("wish_id").Where("user_id = ?", viewer).SubQuery()
+
+		return d.Where(
+			"wishes.owner = ? OR wishes.visibility = ? OR (wishes.owner IN (?) AND "+
+				"(wishes.visibility = ? OR (wishes.visibility = ? AND wishes.id IN (?))))",
+			viewer, VisibilityPublic, friends, VisibilityFriends, VisibilitySelected, audiences).Where(
+			"wishes.wishlist_id IS NULL OR wishes.wishlist_id IN (?)", visibleWishlistIDs(viewer))
+	}
+}
+
+// SetAudience is used to replace wish's audience, only owner's friends
+// can be in the audience
+func SetAudience(tx *gorm.DB, wish *Wish, audience []string) error {
+	var count int
+	var users []User
+
+	if len(audience) != 0 {
+		d := tx.Table("friendships").Where(
+			"user_id = ? AND friend_id IN (?)", wish.Owner, audience).Count(&count)
+		if d.Error != nil {
+			lib.LogError(lib.LPanic, "Could not read user's friends", d.Error)
+		}
+
+		if count != len(audience) {
+			return ErrAudienceNotFriend
+		}
+	}
+
+	for _, u := range audience {
+		users = append(users, User{ID: u})
+	}
+
+	asso := tx.Model(wish).Association(string(WishAudienceAsso))
+	if len(users) == 0 {
+		asso = asso.Clear()
+	} else {
+		asso = asso.Replace(users)
+	}
+
+	err := asso.Error
+	if err != nil {
+		lib.LogError(lib.LPanic, "Could not update wish's audience", err)
+	}
+
+	return nil
+}
+
 func init() {
 	db.DB.AutoMigrate(&Wish{})
+	db.DB.Table(claimTables[WishClaimersAsso]).AutoMigrate(&claim{})
+	db.DB.Table(claimTables[WishFulFillersAsso]).AutoMigrate(&claim{})
 }
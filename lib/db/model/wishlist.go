@@ -0,0 +1,82 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/ryakosh/wishlist/lib/db"
+)
+
+const (
+	WishlistWishesAsso db.Association = "Wishes"
+)
+
+// WishlistColumns are the columns of a wishlist that are exposed by the API
+const WishlistColumns = "id, owner, name, description, visibility, position"
+
+var (
+	// ErrWishlistNotFound is returned when Wishlist does not exist in the database
+	ErrWishlistNotFound = errors.New("Wishlist not found")
+)
+
+// Wishlist is a named list of user's wishes, e.g. for an event, dates of
+// the event are stored as the wishlist's occasions
+type Wishlist struct {
+	ID          int
+	Owner       string     `gorm:"not null;index"`
+	Name        string     `gorm:"type:varchar(256)"`
+	Description string     `gorm:"type:varchar(1024)"`
+	Visibility  Visibility `gorm:"type:varchar(16);not null;default:'public'"`
+	Position    int        `gorm:"not null;default:0"`
+	Wishes      []Wish
+	CreatedAt   *time.Time
+	UpdatedAt   *time.Time
+}
+
+// OwnedBy implements policy.Owned
+func (w *Wishlist) OwnedBy() string {
+	return w.Owner
+}
+
+// VisibleWishlists returns a scope that excludes the wishlists that viewer
+// can not see, viewer is empty for anonymous users
+func VisibleWishlists(viewer string) func(*gorm.DB) *gorm.DB {
+	return func(d *gorm.DB) *gorm.DB {
+		if viewer == "" {
+			return d.Where("wishlists.visibility = ?", VisibilityPublic)
+		}
+
+		friends := db.DB.Table("friendships").Select("friend_id").Where("user_id = ?", viewer).SubQuery()
+
+		return d.Where(
+			"wishlists.owner = ? OR wishlists.visibility = ? OR (wishlists.visibility = ? AND wishlists.owner IN (?))",
+			viewer, VisibilityPublic, VisibilityFriends, friends)
+	}
+}
+
+// DeleteWishlist is used to delete the wishlist and its occasions, its wishes
+// are kept without a wishlist, it should be called in a transaction
+func DeleteWishlist(tx *gorm.DB, id int) error {
+	for _, fk := range wishlistReferences {
+		err := fk.apply(tx, "id = ?", id)
+		if err != nil {
+			return err
+		}
+	}
+
+	d := tx.Delete(&Wishlist{ID: id})
+	if d.Error != nil {
+		return d.Error
+	}
+
+	if d.RowsAffected == 0 {
+		return ErrWishlistNotFound
+	}
+
+	return nil
+}
+
+func init() {
+	db.DB.AutoMigrate(&Wishlist{})
+}
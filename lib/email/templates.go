@@ -2,6 +2,7 @@ package email
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/matcornic/hermes/v2"
 )
@@ -43,3 +44,194 @@ func GenEmailConfirmMail(user string, confirmCode string) (string, error) {
 
 	return email, nil
 }
+
+// GenPasswordResetMail is used to generate a password reset mail
+// containing user's name and password reset code
+func GenPasswordResetMail(user string, resetCode string) (string, error) {
+	templ := hermes.Email{
+		Body: hermes.Body{
+			Title: fmt.Sprintf(defaultTitle, user),
+			Intros: []string{
+				"شما این ایمیل را به علت درخواست بازیابی رمز عبور در سایت ویش لیست دریافت کردید.",
+				fmt.Sprintf("کد بازیابی رمز عبور شما: %s", resetCode),
+			},
+			Outros: []string{
+				"در غیر اینصورت, اگر شما درخواست بازیابی رمز عبور نداده اید نیازی به انجام هیچ فرایندی نیست و رمز عبور شما تغییر نخواهد کرد.",
+			},
+			Signature: defaultSignature,
+		},
+	}
+
+	email, err := mailgen.GenerateHTML(templ)
+	if err != nil {
+		return "", err
+	}
+
+	return email, nil
+}
+
+// GenEmailChangeMail is used to generate a mail that is sent to user's
+// new email address containing user's name and email change code
+func GenEmailChangeMail(user string, changeCode string) (string, error) {
+	templ := hermes.Email{
+		Body: hermes.Body{
+			Title: fmt.Sprintf(defaultTitle, user),
+			Intros: []string{
+				"شما این ایمیل را به علت درخواست تغییر آدرس ایمیل حساب خود در سایت ویش لیست دریافت کردید.",
+				fmt.Sprintf("کد تایید ایمیل جدید شما: %s", changeCode),
+			},
+			Outros: []string{
+				"در غیر اینصورت, اگر شما درخواست تغییر ایمیل نداده اید نیازی به انجام هیچ فرایندی نیست.",
+			},
+			Signature: defaultSignature,
+		},
+	}
+
+	email, err := mailgen.GenerateHTML(templ)
+	if err != nil {
+		return "", err
+	}
+
+	return email, nil
+}
+
+// GenEmailChangedMail is used to generate a mail that is sent to user's
+// old email address notifying them that their email address has changed
+func GenEmailChangedMail(user string, newEmail string) (string, error) {
+	templ := hermes.Email{
+		Body: hermes.Body{
+			Title: fmt.Sprintf(defaultTitle, user),
+			Intros: []string{
+				fmt.Sprintf("آدرس ایمیل حساب شما در سایت ویش لیست به %s تغییر کرد.", newEmail),
+			},
+			Outros: []string{
+				"اگر شما این تغییر را انجام نداده اید, لطفا هرچه سریعتر با پشتیبانی تماس بگیرید.",
+			},
+			Signature: defaultSignature,
+		},
+	}
+
+	email, err := mailgen.GenerateHTML(templ)
+	if err != nil {
+		return "", err
+	}
+
+	return email, nil
+}
+
+// GenDeletionScheduledMail is used to generate a mail notifying the user
+// that their account will be deleted at deleteAt unless they log in
+func GenDeletionScheduledMail(user string, deleteAt time.Time) (string, error) {
+	templ := hermes.Email{
+		Body: hermes.Body{
+			Title: fmt.Sprintf(defaultTitle, user),
+			Intros: []string{
+				"شما این ایمیل را به علت درخواست حذف حساب خود در سایت ویش لیست دریافت کردید.",
+				fmt.Sprintf("حساب شما و تمام اطلاعات آن در تاریخ %s (UTC) حذف خواهد شد.", deleteAt.UTC().Format("2006-01-02 15:04")),
+			},
+			Outros: []string{
+				"اگر از حذف حساب خود منصرف شده اید یا این درخواست را شما نداده اید, کافیست تا قبل از این تاریخ وارد حساب خود شوید.",
+			},
+			Signature: defaultSignature,
+		},
+	}
+
+	email, err := mailgen.GenerateHTML(templ)
+	if err != nil {
+		return "", err
+	}
+
+	return email, nil
+}
+
+// GenDeletionConfirmMail is used to generate a mail containing user's
+// name and the code that confirms their account's deletion
+func GenDeletionConfirmMail(user string, deletionCode string) (string, error) {
+	templ := hermes.Email{
+		Body: hermes.Body{
+			Title: fmt.Sprintf(defaultTitle, user),
+			Intros: []string{
+				"شما این ایمیل را به علت درخواست حذف حساب خود در سایت ویش لیست دریافت کردید.",
+				fmt.Sprintf("کد تایید حذف حساب شما: %s", deletionCode),
+			},
+			Outros: []string{
+				"در غیر اینصورت, اگر شما درخواست حذف حساب نداده اید نیازی به انجام هیچ فرایندی نیست و حساب شما حذف نخواهد شد.",
+			},
+			Signature: defaultSignature,
+		},
+	}
+
+	email, err := mailgen.GenerateHTML(templ)
+	if err != nil {
+		return "", err
+	}
+
+	return email, nil
+}
+
+// GenDeletionCancelledMail is used to generate a mail notifying the user
+// that their account's scheduled deletion was cancelled by logging in
+func GenDeletionCancelledMail(user string) (string, error) {
+	templ := hermes.Email{
+		Body: hermes.Body{
+			Title: fmt.Sprintf(defaultTitle, user),
+			Intros: []string{
+				"حذف حساب شما در سایت ویش لیست به علت ورود به حساب لغو شد.",
+			},
+			Outros: []string{
+				"اگر شما وارد حساب خود نشده اید, لطفا رمز عبور خود را تغییر دهید و با پشتیبانی تماس بگیرید.",
+			},
+			Signature: defaultSignature,
+		},
+	}
+
+	email, err := mailgen.GenerateHTML(templ)
+	if err != nil {
+		return "", err
+	}
+
+	return email, nil
+}
+
+// OccasionWish is a wish that is listed in an occasion reminder mail
+type OccasionWish struct {
+	Name string
+	Link string
+}
+
+// GenOccasionReminderMail is used to generate a mail reminding the user
+// of their friend's occasion on date and friend's unclaimed wishes
+func GenOccasionReminderMail(user string, friend string, occasion string, date time.Time, wishes []OccasionWish) (string, error) {
+	var data [][]hermes.Entry
+
+	for _, w := range wishes {
+		data = append(data, []hermes.Entry{
+			{Key: "آرزو", Value: w.Name},
+			{Key: "لینک", Value: w.Link},
+		})
+	}
+
+	templ := hermes.Email{
+		Body: hermes.Body{
+			Title: fmt.Sprintf(defaultTitle, user),
+			Intros: []string{
+				fmt.Sprintf("مناسبت %s دوست شما %s در تاریخ %s است.", occasion, friend, date.UTC().Format("2006-01-02")),
+				"آرزوهای زیر هنوز توسط کسی برآورده نشده اند:",
+			},
+			Table: hermes.Table{
+				Data: data,
+			},
+			Outros: []string{
+				"برای برآورده کردن یکی از این آرزوها به سایت ویش لیست مراجعه کنید.",
+			},
+			Signature: defaultSignature,
+		},
+	}
+
+	email, err := mailgen.GenerateHTML(templ)
+	if err != nil {
+		return "", err
+	}
+
+	return email, nil
+}
@@ -0,0 +1,44 @@
+package graph
+
+import (
+	"context"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	dbmodel "github.com/ryakosh/wishlist/lib/db/model"
+	"github.com/ryakosh/wishlist/lib/graph/model"
+	"github.com/ryakosh/wishlist/lib/policy"
+)
+
+// HasScopeDirective is used to restrict a field to the credentials that
+// grant scope, it must be placed before @authRequired
+func HasScopeDirective(ctx context.Context, obj interface{}, next graphql.Resolver, scope model.Scope) (interface{}, error) {
+	if !dbmodel.HasScope(ctx, dbmodel.Scope(strings.ToLower(scope.String()))) {
+		return nil, dbmodel.ErrInsufficientScope
+	}
+
+	return next(ctx)
+}
+
+// PolicyDirective is used to restrict a field to the users that any of
+// allow rules grants access to the parent object, it must be placed
+// before @authRequired
+func (r *Resolver) PolicyDirective(ctx context.Context, obj interface{}, next graphql.Resolver, allow []model.Policy) (interface{}, error) {
+	var rules []policy.Rule
+
+	// Connections are checked against the object that they belong to
+	if users, ok := obj.(*model.Users); ok {
+		obj = users.InObj
+		allow = append(allow, users.Allow...)
+	}
+
+	for _, a := range allow {
+		rules = append(rules, policy.Rule(a))
+	}
+
+	if !r.Enforcer.Allowed(r.subject(ctx), obj, rules...) {
+		return nil, dbmodel.ErrUserNotAuthorized
+	}
+
+	return next(ctx)
+}
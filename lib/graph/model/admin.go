@@ -0,0 +1,90 @@
+package model
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+type Role string
+
+const (
+	RoleUser  Role = "USER"
+	RoleAdmin Role = "ADMIN"
+)
+
+var AllRole = []Role{
+	RoleUser,
+	RoleAdmin,
+}
+
+func (e Role) IsValid() bool {
+	switch e {
+	case RoleUser, RoleAdmin:
+		return true
+	}
+	return false
+}
+
+func (e Role) String() string {
+	return string(e)
+}
+
+func (e *Role) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Role(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Role", str)
+	}
+	return nil
+}
+
+func (e Role) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type Report struct {
+	ID         int        `json:"id"`
+	Reporter   *string    `json:"reporter"`
+	WishID     *int       `json:"wishId"`
+	UserID     *string    `json:"userId"`
+	Reason     string     `json:"reason"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	ResolvedBy *string    `json:"resolvedBy"`
+	ResolvedAt *time.Time `json:"resolvedAt"`
+}
+
+type AdminAction struct {
+	ID         int       `json:"id"`
+	Admin      *string   `json:"admin"`
+	Action     string    `json:"action"`
+	TargetType string    `json:"targetType"`
+	TargetID   *string   `json:"targetId"`
+	Reason     *string   `json:"reason"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+type WishReport struct {
+	WishID int    `json:"wishId" validate:"min=0"`
+	Reason string `json:"reason" validate:"min=1,max=1024"`
+}
+
+type UserReport struct {
+	UserID string `json:"userId" validate:"username,max=64"`
+	Reason string `json:"reason" validate:"min=1,max=1024"`
+}
+
+type UserModeration struct {
+	ID     string  `json:"id" validate:"username,max=64"`
+	Reason *string `json:"reason" validate:"omitempty,max=1024"`
+}
+
+type WishModeration struct {
+	ID     int     `json:"id" validate:"min=0"`
+	Reason *string `json:"reason" validate:"omitempty,max=1024"`
+}
@@ -0,0 +1,71 @@
+package model
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+type Scope string
+
+const (
+	ScopeRead    Scope = "READ"
+	ScopeWrite   Scope = "WRITE"
+	ScopeAccount Scope = "ACCOUNT"
+)
+
+var AllScope = []Scope{
+	ScopeRead,
+	ScopeWrite,
+	ScopeAccount,
+}
+
+func (e Scope) IsValid() bool {
+	switch e {
+	case ScopeRead, ScopeWrite, ScopeAccount:
+		return true
+	}
+	return false
+}
+
+func (e Scope) String() string {
+	return string(e)
+}
+
+func (e *Scope) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Scope(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Scope", str)
+	}
+	return nil
+}
+
+func (e Scope) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ApiToken struct {
+	ID         int        `json:"id"`
+	Name       string     `json:"name"`
+	Scopes     []Scope    `json:"scopes"`
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+	ExpiresAt  *time.Time `json:"expiresAt"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
+type CreatedApiToken struct {
+	Token    string    `json:"token"`
+	APIToken *ApiToken `json:"apiToken"`
+}
+
+type NewApiToken struct {
+	Name          string  `json:"name" validate:"min=1,max=64"`
+	Scopes        []Scope `json:"scopes" validate:"min=1,max=2,dive,oneof=READ WRITE"`
+	ExpiresInDays *int    `json:"expiresInDays" validate:"omitempty,min=1,max=3650"`
+}
@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+type Identity struct {
+	Provider  string    `json:"provider"`
+	Email     string    `json:"email"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type OidcCallback struct {
+	State string `json:"state" validate:"max=64"`
+	Code  string `json:"code" validate:"min=1,max=2048"`
+}
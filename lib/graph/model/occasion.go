@@ -0,0 +1,84 @@
+package model
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+type OccasionKind string
+
+const (
+	OccasionKindBirthday OccasionKind = "BIRTHDAY"
+	OccasionKindHoliday  OccasionKind = "HOLIDAY"
+	OccasionKindCustom   OccasionKind = "CUSTOM"
+)
+
+var AllOccasionKind = []OccasionKind{
+	OccasionKindBirthday,
+	OccasionKindHoliday,
+	OccasionKindCustom,
+}
+
+func (e OccasionKind) IsValid() bool {
+	switch e {
+	case OccasionKindBirthday, OccasionKindHoliday, OccasionKindCustom:
+		return true
+	}
+	return false
+}
+
+func (e OccasionKind) String() string {
+	return string(e)
+}
+
+func (e *OccasionKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OccasionKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OccasionKind", str)
+	}
+	return nil
+}
+
+func (e OccasionKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type Occasion struct {
+	ID               int          `json:"id"`
+	Owner            string       `json:"owner"`
+	WishlistID       *int         `json:"wishlistId"`
+	Kind             OccasionKind `json:"kind"`
+	Name             string       `json:"name"`
+	Date             time.Time    `json:"date"`
+	RemindDaysBefore int          `json:"remindDaysBefore"`
+	NextDate         time.Time    `json:"nextDate"`
+}
+
+// OwnedBy implements policy.Owned
+func (o *Occasion) OwnedBy() string {
+	return o.Owner
+}
+
+type NewOccasion struct {
+	WishlistID       *int         `json:"wishlistId" validate:"omitempty,min=0"`
+	Kind             OccasionKind `json:"kind"`
+	Name             string       `json:"name" validate:"min=1,max=256"`
+	Date             time.Time    `json:"date"`
+	RemindDaysBefore int          `json:"remindDaysBefore" validate:"min=0,max=60"`
+}
+
+type UpdateOccasion struct {
+	ID               int           `json:"id" validate:"min=0"`
+	WishlistID       *int          `json:"wishlistId" validate:"omitempty,min=0"`
+	Kind             *OccasionKind `json:"kind"`
+	Name             string        `json:"name" validate:"omitempty,min=1,max=256"`
+	Date             *time.Time    `json:"date"`
+	RemindDaysBefore *int          `json:"remindDaysBefore" validate:"omitempty,min=0,max=60"`
+}
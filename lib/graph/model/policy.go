@@ -0,0 +1,52 @@
+package model
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
+type Policy string
+
+const (
+	PolicyOwner  Policy = "OWNER"
+	PolicyFriend Policy = "FRIEND"
+	PolicySelf   Policy = "SELF"
+	PolicyAdmin  Policy = "ADMIN"
+)
+
+var AllPolicy = []Policy{
+	PolicyOwner,
+	PolicyFriend,
+	PolicySelf,
+	PolicyAdmin,
+}
+
+func (e Policy) IsValid() bool {
+	switch e {
+	case PolicyOwner, PolicyFriend, PolicySelf, PolicyAdmin:
+		return true
+	}
+	return false
+}
+
+func (e Policy) String() string {
+	return string(e)
+}
+
+func (e *Policy) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Policy(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Policy", str)
+	}
+	return nil
+}
+
+func (e Policy) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
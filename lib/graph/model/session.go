@@ -0,0 +1,32 @@
+package model
+
+import "time"
+
+type Token struct {
+	AccessToken  string `json:"accessToken"`
+	RefreshToken string `json:"refreshToken"`
+}
+
+type LoginResult struct {
+	Token              *Token  `json:"token"`
+	TwoFactorChallenge *string `json:"twoFactorChallenge"`
+}
+
+type TwoFactorSetup struct {
+	Secret string `json:"secret"`
+	URI    string `json:"uri"`
+}
+
+type Session struct {
+	ID         string    `json:"id"`
+	UserAgent  string    `json:"userAgent"`
+	IP         string    `json:"ip"`
+	CreatedAt  time.Time `json:"createdAt"`
+	LastUsedAt time.Time `json:"lastUsedAt"`
+	Current    bool      `json:"current"`
+}
+
+type TwoFactorLogin struct {
+	Challenge string `json:"challenge" validate:"max=2048"`
+	Code      string `json:"code" validate:"min=6,max=20"` // TOTP or recovery code
+}
@@ -16,6 +16,8 @@ type Users struct {
 	Count         int         `json:"count"`
 	InObj         interface{} // Parent model
 	InAssociation db.Association
+	Allow         []Policy // Policies that are allowed in addition to the field's
+	Hidden        bool     // Users are hidden from the viewer, e.g. claimers of a surprise wish
 }
 
 type NewUser struct {
@@ -27,11 +29,28 @@ type NewUser struct {
 }
 
 type UpdateUser struct {
-	FirstName *string `json:"firstName" validate:"omitempty,max=64"`
-	LastName  *string `json:"lastName" validate:"omitempty,max=64"`
+	FirstName         *string `json:"firstName" validate:"omitempty,max=64"`
+	LastName          *string `json:"lastName" validate:"omitempty,max=64"`
+	OccasionReminders *bool   `json:"occasionReminders"`
 }
 
 type Login struct {
-	ID       string `json:"id" validate:"username,max=64"`
+	ID       string `json:"id" validate:"max=254,username|email"` // Username or email address
 	Password string `json:"password" validate:"min=8,max=256"`
 }
+
+type ChangePassword struct {
+	CurrentPassword string `json:"currentPassword" validate:"min=8,max=256"`
+	NewPassword     string `json:"newPassword" validate:"min=8,max=256"`
+}
+
+type PasswordReset struct {
+	ID          string `json:"id" validate:"username,max=64"`
+	Code        string `json:"code" validate:"max=14"`
+	NewPassword string `json:"newPassword" validate:"min=8,max=256"`
+}
+
+// UserID implements policy.User
+func (u *User) UserID() string {
+	return u.ID
+}
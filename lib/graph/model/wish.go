@@ -1,35 +1,114 @@
 package model
 
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+type Visibility string
+
+const (
+	VisibilityPublic   Visibility = "PUBLIC"
+	VisibilityFriends  Visibility = "FRIENDS"
+	VisibilitySelected Visibility = "SELECTED"
+	VisibilityPrivate  Visibility = "PRIVATE"
+)
+
+var AllVisibility = []Visibility{
+	VisibilityPublic,
+	VisibilityFriends,
+	VisibilitySelected,
+	VisibilityPrivate,
+}
+
+func (e Visibility) IsValid() bool {
+	switch e {
+	case VisibilityPublic, VisibilityFriends, VisibilitySelected, VisibilityPrivate:
+		return true
+	}
+	return false
+}
+
+func (e Visibility) String() string {
+	return string(e)
+}
+
+func (e *Visibility) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Visibility(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Visibility", str)
+	}
+	return nil
+}
+
+func (e Visibility) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type Wish struct {
-	ID                  int    `json:"id"`
-	Owner               string `json:"owner"`
-	Name                string `json:"name"`
-	Description         string `json:"description"`
-	Link                string `json:"link"`
-	Image               string `json:"image"`
-	FulfillmentClaimers int    `json:"fulfillmentClaimers"`
-	Fulfillers          int    `json:"fulfillers"`
+	ID                  int        `json:"id"`
+	Owner               string     `json:"owner"`
+	WishlistID          *int       `json:"wishlistId"`
+	Position            int        `json:"position"`
+	Name                string     `json:"name"`
+	Description         string     `json:"description"`
+	Link                string     `json:"link"`
+	Image               string     `json:"image"`
+	Quantity            int        `json:"quantity"`
+	Visibility          Visibility `json:"visibility"`
+	Surprise            bool       `json:"surprise"`
+	RevealAt            *time.Time `json:"revealAt"`
+	FulfillmentClaimers int        `json:"fulfillmentClaimers"`
+	Fulfillers          int        `json:"fulfillers"`
+}
+
+// OwnedBy implements policy.Owned
+func (w *Wish) OwnedBy() string {
+	return w.Owner
 }
 
 type Wishes struct {
-	Query string `json:"wishes"`
-	Count int    `json:"count"`
-	InObj *User  // Parent model
+	Query string      `json:"wishes"`
+	Count int         `json:"count"`
+	InObj interface{} // Parent model
 }
 
 type NewWish struct {
-	Name        string `json:"name" validate:"min=1,max=256"`
-	Description string `json:"description" validate:"omitempty,max=1024"`
-	Link        string `json:"link" validate:"omitempty,url"`
-	Image       string `json:"image" validate:"omitempty,url"`
+	WishlistID  *int       `json:"wishlistId" validate:"omitempty,min=0"`
+	Position    int        `json:"position" validate:"min=0"`
+	Name        string     `json:"name" validate:"min=1,max=256"`
+	Description string     `json:"description" validate:"omitempty,max=1024"`
+	Link        string     `json:"link" validate:"omitempty,url"`
+	Image       string     `json:"image" validate:"omitempty,url"`
+	Quantity    int        `json:"quantity" validate:"min=1,max=1000"`
+	Visibility  Visibility `json:"visibility"`
+	Audience    []string   `json:"audience" validate:"max=100,unique,dive,username,max=64"`
+	Surprise    bool       `json:"surprise"`
+	RevealAt    *time.Time `json:"revealAt"`
 }
 
 type UpdateWish struct {
-	ID          int    `json:"id" validate:"min=0"`
-	Name        string `json:"name" validate:"omitempty,min=1,max=256"`
-	Description string `json:"description" validate:"omitempty,max=1024"`
-	Link        string `json:"link" validate:"omitempty,url"`
-	Image       string `json:"image" validate:"omitempty,url"`
+	ID                 int         `json:"id" validate:"min=0"`
+	WishlistID         *int        `json:"wishlistId" validate:"omitempty,min=0"`
+	RemoveFromWishlist bool        `json:"removeFromWishlist"`
+	Position           *int        `json:"position" validate:"omitempty,min=0"`
+	Name               string      `json:"name" validate:"omitempty,min=1,max=256"`
+	Description        string      `json:"description" validate:"omitempty,max=1024"`
+	Link               string      `json:"link" validate:"omitempty,url"`
+	Image              string      `json:"image" validate:"omitempty,url"`
+	Quantity           *int        `json:"quantity" validate:"omitempty,min=1,max=1000"`
+	Visibility         *Visibility `json:"visibility"`
+	Audience           []string    `json:"audience" validate:"omitempty,max=100,unique,dive,username,max=64"` // Nil keeps the current audience
+	Surprise           *bool       `json:"surprise"`
+	RevealAt           *time.Time  `json:"revealAt"`
+	ClearRevealAt      bool        `json:"clearRevealAt"`
 }
 
 type FulfillmentClaimer struct {
@@ -0,0 +1,36 @@
+package model
+
+type Wishlist struct {
+	ID          int        `json:"id"`
+	Owner       string     `json:"owner"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Visibility  Visibility `json:"visibility"`
+	Position    int        `json:"position"`
+}
+
+// OwnedBy implements policy.Owned
+func (w *Wishlist) OwnedBy() string {
+	return w.Owner
+}
+
+type Wishlists struct {
+	Query string `json:"wishlists"`
+	Count int    `json:"count"`
+	InObj *User  // Parent model
+}
+
+type NewWishlist struct {
+	Name        string     `json:"name" validate:"min=1,max=256"`
+	Description string     `json:"description" validate:"omitempty,max=1024"`
+	Visibility  Visibility `json:"visibility" validate:"ne=SELECTED"` // Wishlists have no audience
+	Position    int        `json:"position" validate:"min=0"`
+}
+
+type UpdateWishlist struct {
+	ID          int         `json:"id" validate:"min=0"`
+	Name        string      `json:"name" validate:"omitempty,min=1,max=256"`
+	Description string      `json:"description" validate:"omitempty,max=1024"`
+	Visibility  *Visibility `json:"visibility" validate:"omitempty,ne=SELECTED"`
+	Position    *int        `json:"position" validate:"omitempty,min=0"`
+}
@@ -0,0 +1,28 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	"github.com/ryakosh/wishlist/lib/graph/generated"
+	"github.com/ryakosh/wishlist/lib/graph/model"
+)
+
+func (r *occasionResolver) Owner(ctx context.Context, obj *model.Occasion) (*model.User, error) {
+	return r.user(ctx, obj.Owner)
+}
+
+func (r *occasionResolver) Wishlist(ctx context.Context, obj *model.Occasion) (*model.Wishlist, error) {
+	if obj.WishlistID == nil {
+		return nil, nil
+	}
+
+	return r.wishlist(ctx, *obj.WishlistID)
+}
+
+// Occasion returns generated.OccasionResolver implementation.
+func (r *Resolver) Occasion() generated.OccasionResolver { return &occasionResolver{r} }
+
+type occasionResolver struct{ *Resolver }
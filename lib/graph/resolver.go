@@ -2,73 +2,209 @@ package graph
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"github.com/jinzhu/gorm"
 	"github.com/ryakosh/wishlist/lib"
 	"github.com/ryakosh/wishlist/lib/db"
 	dbmodel "github.com/ryakosh/wishlist/lib/db/model"
+	"github.com/ryakosh/wishlist/lib/email"
 	"github.com/ryakosh/wishlist/lib/graph/model"
+	"github.com/ryakosh/wishlist/lib/oidc"
+	"github.com/ryakosh/wishlist/lib/policy"
+	"github.com/ryakosh/wishlist/lib/throttle"
 )
 
 //go:generate go run github.com/99designs/gqlgen
 
 type Resolver struct {
 	DB *gorm.DB
+
+	// UserLimiter and IPLimiter are used to throttle login attempts
+	// per username and per client's IP address
+	UserLimiter *throttle.Limiter
+	IPLimiter   *throttle.Limiter
+
+	// Enforcer is used to decide whether the authenticated user
+	// can access a resource
+	Enforcer *policy.Enforcer
+}
+
+// subject returns the authenticated user as a policy subject
+func (r *Resolver) subject(ctx context.Context) policy.Subject {
+	authedUser := dbmodel.AuthedUserFromCtx(ctx)
+
+	return policy.Subject{
+		ID:    authedUser,
+		Admin: authedUser != "" && dbmodel.AuthedRoleFromCtx(ctx) == dbmodel.RoleAdmin,
+	}
 }
 
 func (r *Resolver) handleClaimer(ctx context.Context, wishID int,
 	claimer string, appendTo db.Association) (*model.Wish, error) {
 	var wish dbmodel.Wish
 
-	authedUser := dbmodel.AuthedUserFromCtx(ctx)
-
-	d := r.DB.Select("id, name, owner, description, link, image").First(&wish, wishID)
+	d := r.DB.Select(dbmodel.WishColumns).First(&wish, wishID)
 	if d.RecordNotFound() {
 		return nil, dbmodel.ErrWishNotFound
 	}
 
-	if authedUser != wish.Owner {
+	if !r.Enforcer.Allowed(r.subject(ctx), &wish, policy.Owner) {
 		return nil, dbmodel.ErrUserNotAuthorized
 	}
 
+	if wish.Concealed() {
+		return nil, dbmodel.ErrWishConcealed
+	}
+
 	count := r.DB.Model(&wish).Where("user_id = ?", claimer).Association("Claimers").Count()
 	if count != 1 {
 		return nil, dbmodel.ErrUserNotFound
 	}
 
+	// Claim's quantity is carried over so it's not lost when claim is accepted
 	err := r.DB.Transaction(func(tx *gorm.DB) error {
-		asso := tx.Model(&wish).Association(string(appendTo)).Append(&dbmodel.User{ID: claimer})
-		if asso.Error != nil {
-			return asso.Error
-		}
+		quantity := dbmodel.ClaimQuantity(tx, dbmodel.WishClaimersAsso, wish.ID, claimer)
 
-		asso = tx.Model(&wish).Association(string(dbmodel.WishClaimersAsso)).Delete(&dbmodel.User{ID: claimer})
-		if asso.Error != nil {
-			return asso.Error
-		}
-
-		return nil
+		return dbmodel.MoveClaim(tx, wish.ID, claimer, dbmodel.WishClaimersAsso, appendTo, quantity)
 	})
-	if err != nil {
+	if err == dbmodel.ErrUserNotFound {
+		return nil, err
+	} else if err != nil {
 		lib.LogError(lib.LPanic, "Could not accept fulfillment claim", err)
 	}
 
-	return &model.Wish{
-		ID:                  wish.ID,
-		Owner:               wish.Owner,
-		Name:                wish.Name,
-		Description:         wish.Description,
-		Link:                wish.Link,
-		Image:               wish.Image,
-		FulfillmentClaimers: wish.ID,
-		Fulfillers:          wish.ID,
+	return r.wishModel(&wish), nil
+}
+
+// reserveLogin is used to reserve a login attempt before the credentials
+// are verified, the attempt counts as failed unless releaseLogin is called
+func (r *Resolver) reserveLogin(ctx context.Context, username string) error {
+	c := lib.GinCtxFromCtx(ctx)
+
+	err := r.IPLimiter.Reserve(c.ClientIP())
+	if err != nil {
+		return err
+	}
+
+	err = r.UserLimiter.Reserve(username)
+	if err != nil {
+		r.IPLimiter.Release(c.ClientIP())
+		return err
+	}
+
+	return nil
+}
+
+// releaseLogin is used after a successful login attempt, user's failures
+// are forgotten while only the successful attempt is given back to the IP
+func (r *Resolver) releaseLogin(ctx context.Context, username string) {
+	c := lib.GinCtxFromCtx(ctx)
+
+	r.UserLimiter.Reset(username)
+	r.IPLimiter.Release(c.ClientIP())
+}
+
+// login is used to finish logging in the user, users with two-factor
+// authentication enabled only get a challenge that must be verified
+// using verifyTwoFactor mutation, user must have ID, Email and IsTwoFactorEnabled
+func (r *Resolver) login(ctx context.Context, user *dbmodel.User) (*model.LoginResult, error) {
+	// Users that are scheduled for deletion can log in to cancel it
+	if user.SuspendedAt != nil {
+		return nil, dbmodel.ErrUserSuspended
+	}
+
+	if user.IsTwoFactorEnabled {
+		challenge := lib.EncodeChallenge(user.ID)
+
+		return &model.LoginResult{
+			TwoFactorChallenge: &challenge,
+		}, nil
+	}
+
+	return &model.LoginResult{
+		Token: r.createSession(ctx, user),
 	}, nil
 }
 
+// beginOIDC is used to start an OIDC login with provider and returns the
+// provider's authorization URL, userID is set when linking an identity
+func (r *Resolver) beginOIDC(provider string, userID *string) (string, error) {
+	p, err := oidc.Get(provider)
+	if err != nil {
+		return "", err
+	}
+
+	verifier, challenge, err := oidc.GenPKCE()
+	if err != nil {
+		lib.LogError(lib.LPanic, "Could not generate PKCE code verifier", err)
+	}
+
+	state := dbmodel.CreateOIDCState(provider, verifier, userID)
+
+	return p.AuthURL(state.State, state.Nonce, challenge)
+}
+
+// exchangeOIDC is used to exchange the authorization code of an OIDC
+// login for the user's verified ID token
+func (r *Resolver) exchangeOIDC(state *dbmodel.OIDCState, code string) (*oidc.IDToken, error) {
+	provider, err := oidc.Get(state.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	return provider.Exchange(code, state.Verifier, state.Nonce)
+}
+
+// verifyOIDCEmail marks user's email as verified when the identity
+// provider has verified the same address
+func (r *Resolver) verifyOIDCEmail(user *dbmodel.User, idToken *oidc.IDToken) {
+	if idToken.EmailVerified && !user.IsEmailVerified && strings.EqualFold(idToken.Email, user.Email) {
+		d := r.DB.Model(user).Update("is_email_verified", true)
+		if d.Error != nil {
+			lib.LogError(lib.LPanic, "Could not update user", d.Error)
+		}
+	}
+}
+
+// createSession is used to log the user in, logging in cancels user's
+// scheduled deletion
+func (r *Resolver) createSession(ctx context.Context, user *dbmodel.User) *model.Token {
+	c := lib.GinCtxFromCtx(ctx)
+
+	if dbmodel.CancelDeletion(user) {
+		r.sendDeletionCancelledMail(user)
+	}
+	tokens := dbmodel.CreateSession(user, c.Request.UserAgent(), c.ClientIP())
+
+	return &model.Token{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	}
+}
+
+func (r *Resolver) apiToken(token *dbmodel.APIToken) *model.ApiToken {
+	var scopes []model.Scope
+
+	for _, s := range token.ScopeList() {
+		scopes = append(scopes, model.Scope(strings.ToUpper(string(s))))
+	}
+
+	return &model.ApiToken{
+		ID:         token.ID,
+		Name:       token.Name,
+		Scopes:     scopes,
+		LastUsedAt: token.LastUsedAt,
+		ExpiresAt:  token.ExpiresAt,
+		CreatedAt:  *token.CreatedAt,
+	}
+}
+
 func (r *Resolver) user(ctx context.Context, id string) (*model.User, error) {
 	var user dbmodel.User
 
-	d := r.DB.Select("id, first_name, last_name").Where("id = ?", id).First(&user)
+	d := r.DB.Scopes(dbmodel.ActiveUsers).Select("id, first_name, last_name").Where("id = ?", id).First(&user)
 	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
 		lib.LogError(lib.LPanic, "Could not read user", d.Error)
 	} else if d.RecordNotFound() {
@@ -85,22 +221,392 @@ func (r *Resolver) user(ctx context.Context, id string) (*model.User, error) {
 }
 
 func (r *Resolver) wish(ctx context.Context, wishID int) (*model.Wish, error) {
+	wish, err := r.visibleWish(ctx, wishID)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.wishModel(wish), nil
+}
+
+// visibleWish is used to read a wish that the authenticated user can see
+func (r *Resolver) visibleWish(ctx context.Context, wishID int) (*dbmodel.Wish, error) {
 	var wish dbmodel.Wish
 
-	d := r.DB.Omit("created_at, updated_at").First(&wish, wishID)
+	viewer := dbmodel.AuthedUserFromCtx(ctx)
+
+	d := r.DB.Scopes(dbmodel.ActiveOwners, dbmodel.VisibleWishes(viewer)).Select(
+		dbmodel.WishColumns).First(&wish, wishID)
 	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
 		lib.LogError(lib.LPanic, "Could not read wish", d.Error)
 	} else if d.RecordNotFound() {
 		return nil, dbmodel.ErrWishNotFound
 	}
+
+	return &wish, nil
+}
+
+func (r *Resolver) wishModel(wish *dbmodel.Wish) *model.Wish {
 	return &model.Wish{
 		ID:                  wish.ID,
 		Owner:               wish.Owner,
+		WishlistID:          wish.WishlistID,
+		Position:            wish.Position,
 		Name:                wish.Name,
 		Description:         wish.Description,
 		Link:                wish.Link,
 		Image:               wish.Image,
+		Quantity:            wish.Quantity,
+		Visibility:          model.Visibility(strings.ToUpper(string(wish.Visibility))),
+		Surprise:            wish.Surprise,
+		RevealAt:            wish.RevealAt,
 		FulfillmentClaimers: wish.ID,
 		Fulfillers:          wish.ID,
-	}, nil
+	}
+}
+
+func (r *Resolver) wishlist(ctx context.Context, wishlistID int) (*model.Wishlist, error) {
+	var wishlist dbmodel.Wishlist
+
+	viewer := dbmodel.AuthedUserFromCtx(ctx)
+
+	d := r.DB.Scopes(dbmodel.VisibleWishlists(viewer), dbmodel.ActiveOwners).Select(
+		dbmodel.WishlistColumns).First(&wishlist, wishlistID)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read wishlist", d.Error)
+	} else if d.RecordNotFound() {
+		return nil, dbmodel.ErrWishlistNotFound
+	}
+
+	return r.wishlistModel(&wishlist), nil
+}
+
+// checkWishlist is used to make sure that wishes are only added to
+// the authenticated user's own wishlists
+func (r *Resolver) checkWishlist(ctx context.Context, wishlistID int) error {
+	var count int
+
+	authedUser := dbmodel.AuthedUserFromCtx(ctx)
+
+	d := r.DB.Model(&dbmodel.Wishlist{}).Where("id = ? AND owner = ?", wishlistID, authedUser).Count(&count)
+	if d.Error != nil {
+		lib.LogError(lib.LPanic, "Could not read wishlist", d.Error)
+	}
+
+	if count == 0 {
+		return dbmodel.ErrWishlistNotFound
+	}
+
+	return nil
+}
+
+func (r *Resolver) wishlistModel(wishlist *dbmodel.Wishlist) *model.Wishlist {
+	return &model.Wishlist{
+		ID:          wishlist.ID,
+		Owner:       wishlist.Owner,
+		Name:        wishlist.Name,
+		Description: wishlist.Description,
+		Visibility:  model.Visibility(strings.ToUpper(string(wishlist.Visibility))),
+		Position:    wishlist.Position,
+	}
+}
+
+// wishesOf returns the parent model of wishes and its association to them,
+// wishes of a wishlist are ordered by their position in the wishlist
+func (r *Resolver) wishesOf(wishes *model.Wishes) (*gorm.DB, db.Association) {
+	switch o := wishes.InObj.(type) {
+	case *model.User:
+		return r.DB.Model(&dbmodel.User{ID: o.ID}), dbmodel.UserWishesAsso
+	case *model.Wishlist:
+		return r.DB.Model(&dbmodel.Wishlist{ID: o.ID}).Order("position, id"), dbmodel.WishlistWishesAsso
+	default:
+		lib.LogError(lib.LPanic, "Model object type assertion failed", nil)
+	}
+
+	return nil, ""
+}
+
+// claims is used to build a connection of wish's claimers or fulfillers,
+// for surprise wishes they are visible to owner's friends so they can
+// coordinate and are hidden from the owner until the wish is revealed
+func (r *Resolver) claims(ctx context.Context, wish *model.Wish, asso db.Association) *model.Users {
+	users := &model.Users{
+		InObj:         wish,
+		InAssociation: asso,
+	}
+
+	if wish.Surprise {
+		concealed := (&dbmodel.Wish{Surprise: wish.Surprise, RevealAt: wish.RevealAt}).Concealed()
+
+		users.Allow = []model.Policy{model.PolicyFriend}
+		users.Hidden = concealed && dbmodel.AuthedUserFromCtx(ctx) == wish.Owner
+	}
+
+	return users
+}
+
+func (r *Resolver) sendEmailConfirmMail(user *dbmodel.User) error {
+	code, err := dbmodel.CreateCode(user.ID, dbmodel.CodeEmailVerification)
+	if err != nil {
+		se, ok := err.(*dbmodel.ServerError)
+		if ok {
+			lib.LogError(lib.LError, "Could not generate email confirmation mail", se.Reason)
+			return email.ErrSendMail
+		}
+
+		return err
+	}
+
+	mail, err := email.GenEmailConfirmMail(user.ID, code.View.(string))
+	if err != nil {
+		lib.LogError(lib.LError, "Could not generate email confirmation mail", err)
+		return email.ErrSendMail
+	}
+
+	err = email.Send(email.BotEmailEnv, user.Email, "لطفا ایمیل خود را تایید کنید [ویش لیست]", mail)
+	if err != nil {
+		lib.LogError(lib.LError, "Could not send email confirmation mail", err)
+		return email.ErrSendMail
+	}
+
+	return nil
+}
+
+func (r *Resolver) sendPasswordResetMail(user *dbmodel.User) error {
+	code, err := dbmodel.CreateCode(user.ID, dbmodel.CodePasswordReset)
+	if err != nil {
+		se, ok := err.(*dbmodel.ServerError)
+		if ok {
+			lib.LogError(lib.LError, "Could not generate password reset mail", se.Reason)
+			return email.ErrSendMail
+		}
+
+		return err
+	}
+
+	mail, err := email.GenPasswordResetMail(user.ID, code.View.(string))
+	if err != nil {
+		lib.LogError(lib.LError, "Could not generate password reset mail", err)
+		return email.ErrSendMail
+	}
+
+	err = email.Send(email.BotEmailEnv, user.Email, "بازیابی رمز عبور [ویش لیست]", mail)
+	if err != nil {
+		lib.LogError(lib.LError, "Could not send password reset mail", err)
+		return email.ErrSendMail
+	}
+
+	return nil
+}
+
+func (r *Resolver) sendEmailChangeMail(user *dbmodel.User, newEmail string) error {
+	code, err := dbmodel.CreateCode(user.ID, dbmodel.CodeEmailChange)
+	if err != nil {
+		se, ok := err.(*dbmodel.ServerError)
+		if ok {
+			lib.LogError(lib.LError, "Could not generate email change mail", se.Reason)
+			return email.ErrSendMail
+		}
+
+		return err
+	}
+
+	mail, err := email.GenEmailChangeMail(user.ID, code.View.(string))
+	if err != nil {
+		lib.LogError(lib.LError, "Could not generate email change mail", err)
+		return email.ErrSendMail
+	}
+
+	err = email.Send(email.BotEmailEnv, newEmail, "لطفا ایمیل جدید خود را تایید کنید [ویش لیست]", mail)
+	if err != nil {
+		lib.LogError(lib.LError, "Could not send email change mail", err)
+		return email.ErrSendMail
+	}
+
+	return nil
+}
+
+func (r *Resolver) sendEmailChangedMail(user *dbmodel.User, newEmail string) {
+	mail, err := email.GenEmailChangedMail(user.ID, newEmail)
+	if err != nil {
+		lib.LogError(lib.LError, "Could not generate email changed mail", err)
+		return
+	}
+
+	err = email.Send(email.BotEmailEnv, user.Email, "ایمیل حساب شما تغییر کرد [ویش لیست]", mail)
+	if err != nil {
+		lib.LogError(lib.LError, "Could not send email changed mail", err)
+	}
+}
+
+func (r *Resolver) sendDeletionScheduledMail(user *dbmodel.User, deleteAt time.Time) {
+	mail, err := email.GenDeletionScheduledMail(user.ID, deleteAt)
+	if err != nil {
+		lib.LogError(lib.LError, "Could not generate deletion scheduled mail", err)
+		return
+	}
+
+	err = email.Send(email.BotEmailEnv, user.Email, "حساب شما حذف خواهد شد [ویش لیست]", mail)
+	if err != nil {
+		lib.LogError(lib.LError, "Could not send deletion scheduled mail", err)
+	}
+}
+
+func (r *Resolver) sendDeletionConfirmMail(user *dbmodel.User) error {
+	code, err := dbmodel.CreateCode(user.ID, dbmodel.CodeAccountDeletion)
+	if err != nil {
+		se, ok := err.(*dbmodel.ServerError)
+		if ok {
+			lib.LogError(lib.LError, "Could not generate deletion confirmation mail", se.Reason)
+			return email.ErrSendMail
+		}
+
+		return err
+	}
+
+	mail, err := email.GenDeletionConfirmMail(user.ID, code.View.(string))
+	if err != nil {
+		lib.LogError(lib.LError, "Could not generate deletion confirmation mail", err)
+		return email.ErrSendMail
+	}
+
+	err = email.Send(email.BotEmailEnv, user.Email, "تایید حذف حساب [ویش لیست]", mail)
+	if err != nil {
+		lib.LogError(lib.LError, "Could not send deletion confirmation mail", err)
+		return email.ErrSendMail
+	}
+
+	return nil
+}
+
+func (r *Resolver) sendDeletionCancelledMail(user *dbmodel.User) {
+	mail, err := email.GenDeletionCancelledMail(user.ID)
+	if err != nil {
+		lib.LogError(lib.LError, "Could not generate deletion cancelled mail", err)
+		return
+	}
+
+	err = email.Send(email.BotEmailEnv, user.Email, "حذف حساب شما لغو شد [ویش لیست]", mail)
+	if err != nil {
+		lib.LogError(lib.LError, "Could not send deletion cancelled mail", err)
+	}
+}
+
+// calendarDate returns the day of t in the time zone that the client sent
+// it in, converting it to UTC first would move the day for clients that
+// are ahead of UTC
+func calendarDate(t time.Time) time.Time {
+	y, m, d := t.Date()
+
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// SendOccasionReminder is used to mail a reminder of an occasion to
+// one of its owner's friends, it implements dbmodel.ReminderSender
+func SendOccasionReminder(reminder *dbmodel.Reminder) error {
+	var wishes []email.OccasionWish
+
+	for _, w := range reminder.Wishes {
+		wishes = append(wishes, email.OccasionWish{Name: w.Name, Link: w.Link})
+	}
+
+	mail, err := email.GenOccasionReminderMail(reminder.Friend.ID, reminder.Occasion.Owner,
+		reminder.Occasion.Name, reminder.Date, wishes)
+	if err != nil {
+		return err
+	}
+
+	return email.Send(email.BotEmailEnv, reminder.Friend.Email, "یادآوری مناسبت [ویش لیست]", mail)
+}
+
+func (r *Resolver) occasionModel(occasion *dbmodel.Occasion) *model.Occasion {
+	return &model.Occasion{
+		ID:               occasion.ID,
+		Owner:            occasion.Owner,
+		WishlistID:       occasion.WishlistID,
+		Kind:             model.OccasionKind(strings.ToUpper(string(occasion.Kind))),
+		Name:             occasion.Name,
+		Date:             occasion.Date,
+		RemindDaysBefore: occasion.RemindDaysBefore,
+		NextDate:         occasion.NextDate(time.Now()),
+	}
+}
+
+func (r *Resolver) report(report *dbmodel.Report) *model.Report {
+	return &model.Report{
+		ID:         report.ID,
+		Reporter:   report.ReporterID,
+		WishID:     report.WishID,
+		UserID:     report.ReportedUserID,
+		Reason:     report.Reason,
+		CreatedAt:  *report.CreatedAt,
+		ResolvedBy: report.ResolvedBy,
+		ResolvedAt: report.ResolvedAt,
+	}
+}
+
+// createReport is used to report content unless the authenticated user
+// has an unresolved report on it already
+func (r *Resolver) createReport(ctx context.Context, report *dbmodel.Report) (*model.Report, error) {
+	var count int
+
+	authedUser := dbmodel.AuthedUserFromCtx(ctx)
+	report.ReporterID = &authedUser
+
+	d := r.DB.Model(&dbmodel.Report{}).Where(
+		"reporter_id = ? AND resolved_at IS NULL", authedUser).Where(
+		&dbmodel.Report{WishID: report.WishID, ReportedUserID: report.ReportedUserID}).Count(&count)
+	if d.Error != nil {
+		lib.LogError(lib.LPanic, "Could not read reports", d.Error)
+	}
+
+	if count != 0 {
+		return nil, dbmodel.ErrReportExists
+	}
+
+	d = r.DB.Create(report)
+	if d.Error != nil {
+		lib.LogError(lib.LPanic, "Could not create report", d.Error)
+	}
+
+	return r.report(report), nil
+}
+
+// moderateUser is used to update the user as an admin and record the
+// action, admins can not moderate themselves
+func (r *Resolver) moderateUser(ctx context.Context, input model.UserModeration,
+	action dbmodel.AdminActionKind, update func(tx *gorm.DB, user *dbmodel.User) error) error {
+	var user dbmodel.User
+
+	authedUser := dbmodel.AuthedUserFromCtx(ctx)
+
+	err := lib.Validator.Struct(&input)
+	if err != nil {
+		return lib.ErrValidationFailed
+	}
+
+	if input.ID == authedUser {
+		return dbmodel.ErrUserNotAuthorized
+	}
+
+	d := r.DB.Select("id, is_email_verified, role, suspended_at").Where("id = ?", input.ID).First(&user)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read user", d.Error)
+	} else if d.RecordNotFound() {
+		return dbmodel.ErrUserNotFound
+	}
+
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		err := update(tx, &user)
+		if err != nil {
+			return err
+		}
+
+		err = dbmodel.RecordAdminAction(tx, authedUser, action, dbmodel.TargetUser, user.ID, input.Reason)
+		if err != nil {
+			lib.LogError(lib.LPanic, "Could not record admin action", err)
+		}
+
+		return nil
+	})
 }
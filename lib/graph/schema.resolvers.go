@@ -5,14 +5,20 @@ package graph
 
 import (
 	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/jinzhu/gorm"
 	"github.com/ryakosh/wishlist/lib"
 	"github.com/ryakosh/wishlist/lib/db"
 	dbmodel "github.com/ryakosh/wishlist/lib/db/model"
-	"github.com/ryakosh/wishlist/lib/email"
 	"github.com/ryakosh/wishlist/lib/graph/generated"
 	"github.com/ryakosh/wishlist/lib/graph/model"
+	"github.com/ryakosh/wishlist/lib/oidc"
+	"github.com/ryakosh/wishlist/lib/policy"
 )
 
 func (r *mutationResolver) CreateUser(ctx context.Context, input model.NewUser) (*model.User, error) {
@@ -43,29 +49,11 @@ func (r *mutationResolver) CreateUser(ctx context.Context, input model.NewUser)
 		lib.LogError(lib.LPanic, "Could not create user", d.Error)
 	}
 
-	code, err := dbmodel.CreateCode(input.ID)
+	err = r.sendEmailConfirmMail(&user)
 	if err != nil {
-		se, ok := err.(*dbmodel.ServerError)
-		if ok {
-			lib.LogError(lib.LError, "Could not generate email confirmation mail", se.Reason)
-			return nil, email.ErrSendMail
-		}
-
 		return nil, err
 	}
 
-	mail, err := email.GenEmailConfirmMail(input.ID, code.View.(string))
-	if err != nil {
-		lib.LogError(lib.LError, "Could not generate email confirmation mail", err)
-		return nil, email.ErrSendMail
-	}
-
-	err = email.Send(email.BotEmailEnv, input.Email, "لطفا ایمیل خود را تایید کنید [ویش لیست]", mail)
-	if err != nil {
-		lib.LogError(lib.LError, "Could not generate email confirmation mail", err)
-		return nil, email.ErrSendMail
-	}
-
 	return &model.User{
 		ID:             user.ID,
 		FirstName:      user.FirstName,
@@ -91,6 +79,14 @@ func (r *mutationResolver) UpdateUser(ctx context.Context, input model.UpdateUse
 		lib.LogError(lib.LPanic, "Could not update user", d.Error)
 	}
 
+	// Updates skips zero values so this is updated separately
+	if input.OccasionReminders != nil {
+		d := r.DB.Model(&dbmodel.User{ID: authedUser}).Update("occasion_reminders", *input.OccasionReminders)
+		if d.Error != nil {
+			lib.LogError(lib.LPanic, "Could not update user", d.Error)
+		}
+	}
+
 	return &model.User{
 		ID:             authedUser,
 		FirstName:      input.FirstName,
@@ -100,321 +96,1164 @@ func (r *mutationResolver) UpdateUser(ctx context.Context, input model.UpdateUse
 	}, nil
 }
 
-func (r *mutationResolver) DeleteUser(ctx context.Context) (string, error) {
+func (r *mutationResolver) RequestUserDeletion(ctx context.Context) (bool, error) {
+	var user dbmodel.User
+
 	authedUser := dbmodel.AuthedUserFromCtx(ctx)
 
-	d := r.DB.Delete(&dbmodel.User{ID: authedUser})
-	if d.Error != nil {
-		lib.LogError(lib.LPanic, "Could not delete user", d.Error)
+	d := r.DB.Select("id, email").Where("id = ?", authedUser).First(&user)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read user", d.Error)
+	} else if d.RecordNotFound() {
+		return false, dbmodel.ErrUserNotFound
+	}
+
+	err := r.sendDeletionConfirmMail(&user)
+	if err != nil {
+		return false, err
 	}
 
-	return authedUser, nil
+	return true, nil
 }
 
-func (r *mutationResolver) GenToken(ctx context.Context, input model.Login) (string, error) {
+func (r *mutationResolver) DeleteUser(ctx context.Context, password *string, code *string) (*time.Time, error) {
 	var user dbmodel.User
 
-	err := lib.Validator.Struct(&input)
+	authedUser := dbmodel.AuthedUserFromCtx(ctx)
+
+	// Users without a password, such as the ones that only log in using
+	// OIDC, confirm the deletion with a code that is mailed to them
+	if (password == nil) == (code == nil) {
+		return nil, lib.ErrValidationFailed
+	}
+
+	err := lib.Validator.Struct(struct {
+		Password *string `validate:"omitempty,min=8,max=256"`
+		Code     *string `validate:"omitempty,max=14"`
+	}{
+		Password: password,
+		Code:     code,
+	})
 	if err != nil {
-		return "", lib.ErrValidationFailed
+		return nil, lib.ErrValidationFailed
 	}
 
-	d := r.DB.Select("id, email, password").Where("id = ?", input.ID).First(&user)
+	d := r.DB.Select("id, email, password").Where("id = ?", authedUser).First(&user)
 	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
 		lib.LogError(lib.LPanic, "Could not read user", d.Error)
-	} else if d.RecordNotFound() || !dbmodel.VerifyPassword(input.Password, user.Password) {
-		return "", dbmodel.ErrUnmOrPwdIncorrect
+	} else if d.RecordNotFound() {
+		return nil, dbmodel.ErrUserNotFound
+	}
+
+	if password != nil {
+		err := r.reserveLogin(ctx, authedUser)
+		if err != nil {
+			return nil, err
+		}
+
+		if !dbmodel.VerifyPassword(*password, user.Password) {
+			return nil, dbmodel.ErrUnmOrPwdIncorrect
+		}
+
+		r.releaseLogin(ctx, authedUser)
+	} else {
+		_, err := dbmodel.VerifyCode(authedUser, dbmodel.CodeAccountDeletion, *code)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	return lib.Encode(user.ID, user.Email), nil
+	deleteAt := dbmodel.ScheduleDeletion(authedUser)
+	r.sendDeletionScheduledMail(&user, deleteAt)
+
+	return &deleteAt, nil
 }
 
-func (r *mutationResolver) VerifyEmail(ctx context.Context, code string) (bool, error) {
+func (r *mutationResolver) GenToken(ctx context.Context, input model.Login) (*model.LoginResult, error) {
 	var user dbmodel.User
 
-	authedUser := dbmodel.AuthedUserFromCtx(ctx)
-
-	err := lib.Validator.Var(code, "max=14")
+	err := lib.Validator.Struct(&input)
 	if err != nil {
-		return false, lib.ErrValidationFailed
+		return nil, lib.ErrValidationFailed
 	}
 
-	d := r.DB.Select("is_email_verified").Where("id = ?", authedUser).First(&user)
+	column := "id"
+	if lib.Validator.Var(input.ID, "email") == nil {
+		column = "email"
+	}
+
+	d := r.DB.Select("id, email, password, is_two_factor_enabled, suspended_at, deletion_scheduled_at").Where(column+" = ?", input.ID).First(&user)
 	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
 		lib.LogError(lib.LPanic, "Could not read user", d.Error)
-	} else if d.RecordNotFound() {
-		return false, dbmodel.ErrUserNotFound
 	}
 
-	if user.IsEmailVerified {
-		return false, dbmodel.ErrEmailVerified
+	// Attempts are throttled per account, so that switching between
+	// username and email address does not bypass the limits
+	throttleKey := strings.ToLower(input.ID)
+	if !d.RecordNotFound() {
+		throttleKey = user.ID
 	}
 
-	isMatch, err := dbmodel.VerifyCode(authedUser, code)
+	err = r.reserveLogin(ctx, throttleKey)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
-	if isMatch.View.(bool) {
-		d := db.DB.Model(&dbmodel.User{ID: authedUser}).Update("is_email_verified", true)
+	if d.RecordNotFound() {
+		dbmodel.VerifyDummyPassword(input.Password)
+		return nil, dbmodel.ErrUnmOrPwdIncorrect
+	} else if !dbmodel.VerifyPassword(input.Password, user.Password) {
+		return nil, dbmodel.ErrUnmOrPwdIncorrect
+	}
+
+	r.releaseLogin(ctx, throttleKey)
+
+	if dbmodel.NeedsRehash(user.Password) {
+		d := r.DB.Model(&user).Update("password", dbmodel.GenPasswordHash(input.Password))
 		if d.Error != nil {
 			lib.LogError(lib.LPanic, "Could not update user", d.Error)
 		}
 	}
 
-	return true, nil
+	return r.login(ctx, &user)
 }
 
-func (r *mutationResolver) SendFriendRequest(ctx context.Context, id string) (*model.User, error) {
-	var requestee dbmodel.User
-	var friendsCount uint8
-	var friendRequestsCount uint8
-
-	authedUser := dbmodel.AuthedUserFromCtx(ctx)
+func (r *mutationResolver) VerifyTwoFactor(ctx context.Context, input model.TwoFactorLogin) (*model.Token, error) {
+	var user dbmodel.User
 
-	err := lib.Validator.Var(id, "username,max=64")
+	err := lib.Validator.Struct(&input)
 	if err != nil {
 		return nil, lib.ErrValidationFailed
 	}
 
-	if authedUser == id {
-		return nil, dbmodel.ErrUserNotFound
+	sub, err := lib.DecodeChallenge(input.Challenge)
+	if err != nil {
+		return nil, err
 	}
 
-	d := r.DB.Select("id, first_name, last_name").Where("id = ?", id).First(&requestee)
+	err = r.reserveLogin(ctx, sub)
+	if err != nil {
+		return nil, err
+	}
+
+	d := r.DB.Select("id, email, two_factor_secret, two_factor_last_step, suspended_at, deletion_scheduled_at").Where(
+		"id = ? AND is_two_factor_enabled = ?", sub, true).First(&user)
 	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
 		lib.LogError(lib.LPanic, "Could not read user", d.Error)
 	} else if d.RecordNotFound() {
 		return nil, dbmodel.ErrUserNotFound
 	}
 
-	d = r.DB.Table("friendrequests").Where("user_id = ? AND requester_id = ?", id, authedUser).Count(&friendRequestsCount)
-	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
-		lib.LogError(lib.LPanic, "Could not read user", d.Error)
+	// Suspended users are rejected before the code is checked so that
+	// their codes are not consumed and can not be tested
+	if user.SuspendedAt != nil {
+		return nil, dbmodel.ErrUserSuspended
 	}
 
-	d = r.DB.Table("friendships").Where("user_id = ? AND friend_id = ?", authedUser, id).Count(&friendsCount)
-	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
-		lib.LogError(lib.LPanic, "Could not read user", d.Error)
+	if !dbmodel.VerifyTwoFactor(&user, input.Code) {
+		return nil, dbmodel.ErrTwoFactorCodeInvalid
 	}
 
-	if friendRequestsCount != 0 || friendsCount != 0 {
-		return nil, dbmodel.ErrUserExists
-	}
+	r.releaseLogin(ctx, sub)
 
-	err = r.DB.Model(&dbmodel.User{ID: id}).Association("FriendRequests").Append(&dbmodel.User{ID: authedUser}).Error
+	return r.createSession(ctx, &user), nil
+}
+
+func (r *mutationResolver) BeginOidcLogin(ctx context.Context, provider string) (string, error) {
+	err := lib.Validator.Var(provider, "max=64")
 	if err != nil {
-		lib.LogError(lib.LPanic, "Could not request friendship", err)
+		return "", lib.ErrValidationFailed
 	}
 
-	return &model.User{
-		ID:             requestee.ID,
-		FirstName:      requestee.FirstName,
-		LastName:       requestee.LastName,
-		Friends:        requestee.ID,
-		FriendRequests: requestee.ID,
-	}, nil
+	return r.beginOIDC(provider, nil)
 }
 
-func (r *mutationResolver) UnSendFriendRequest(ctx context.Context, id string) (*model.User, error) {
-	var requestees []dbmodel.User
-
-	authedUser := dbmodel.AuthedUserFromCtx(ctx)
+func (r *mutationResolver) CompleteOidcLogin(ctx context.Context, input model.OidcCallback) (*model.LoginResult, error) {
+	var identity dbmodel.Identity
+	var user dbmodel.User
+	var userID string
 
-	err := lib.Validator.Var(id, "username,max=64")
+	err := lib.Validator.Struct(&input)
 	if err != nil {
 		return nil, lib.ErrValidationFailed
 	}
 
-	err = r.DB.Model(&dbmodel.User{ID: id}).Select("id, first_name, last_name").Where("requester_id = ?", authedUser).Association("FriendRequests").Find(&requestees).Error
-	if gorm.IsRecordNotFoundError(err) {
-		return nil, dbmodel.ErrUserNotFound
-	} else if err != nil {
-		lib.LogError(lib.LPanic, "Could not delete friendship request", err)
-	}
-
-	err = r.DB.Model(&dbmodel.User{ID: id}).Association("FriendRequests").Delete(&dbmodel.User{ID: authedUser}).Error
+	state, err := dbmodel.ConsumeOIDCState(input.State, nil)
 	if err != nil {
-		lib.LogError(lib.LPanic, "Could not delete friendship request", err)
+		return nil, err
 	}
 
-	return &model.User{
-		ID:             requestees[0].ID,
-		FirstName:      requestees[0].FirstName,
-		LastName:       requestees[0].LastName,
-		Friends:        requestees[0].ID,
-		FriendRequests: requestees[0].ID,
-	}, nil
-}
-
-func (r *mutationResolver) AcceptFriendRequest(ctx context.Context, id string) (*model.User, error) {
-	var requestees []dbmodel.User
-
-	authedUser := dbmodel.AuthedUserFromCtx(ctx)
-
-	err := lib.Validator.Var(id, "username,max=64")
+	idToken, err := r.exchangeOIDC(state, input.Code)
 	if err != nil {
-		return nil, lib.ErrValidationFailed
+		return nil, err
 	}
 
-	d := r.DB.Model(&dbmodel.User{ID: authedUser}).Select("id, first_name, last_name").Where(
-		"requester_id = ?", id).Related(&requestees, "FriendRequests")
+	d := r.DB.Where("provider = ? AND subject = ?", state.Provider, idToken.Subject).First(&identity)
 	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
-		lib.LogError(lib.LPanic, "Could not read user's friend requests", d.Error)
+		lib.LogError(lib.LPanic, "Could not read identity", d.Error)
 	}
+	identityExists := !d.RecordNotFound()
 
-	if len(requestees) != 1 {
-		return nil, dbmodel.ErrUserNotFound
-	}
+	if identityExists {
+		userID = identity.UserID
+	} else {
+		var count int
 
-	err = r.DB.Transaction(func(tx *gorm.DB) error {
-		err := tx.Model(&dbmodel.User{ID: authedUser}).Association("Friends").Append(requestees[0]).Error
-		if err != nil {
-			return err
+		if idToken.Email == "" {
+			return nil, dbmodel.ErrIdentityEmailMissing
 		}
 
-		err = tx.Model(&dbmodel.User{ID: requestees[0].ID}).Association("Friends").Append(&dbmodel.User{ID: authedUser}).Error
-		if err != nil {
-			return err
+		// Unverified emails may belong to anyone, the account would be
+		// created under someone else's address
+		if !idToken.EmailVerified {
+			return nil, dbmodel.ErrIdentityEmailUnverified
 		}
 
-		err = tx.Model(&dbmodel.User{ID: authedUser}).Association("FriendRequests").Delete(requestees[0]).Error
-		if err != nil {
-			return err
+		d := r.DB.Model(&dbmodel.User{}).Where("lower(email) = lower(?)", idToken.Email).Count(&count)
+		if d.Error != nil {
+			lib.LogError(lib.LPanic, "Could not read user", d.Error)
 		}
 
-		return nil
-	})
+		// Existing users must log in and link their identity, otherwise
+		// anyone controlling an identity with the same email would take over the account
+		if count != 0 {
+			return nil, dbmodel.ErrUserExists
+		}
 
-	if err != nil {
-		lib.LogError(lib.LPanic, " Could not accept friendship", err)
-	}
+		base := idToken.PreferredUsername
+		if base == "" {
+			base = strings.Split(idToken.Email, "@")[0]
+		}
 
-	return &model.User{
-		ID:             requestees[0].ID,
-		FirstName:      requestees[0].FirstName,
-		LastName:       requestees[0].LastName,
-		Friends:        requestees[0].ID,
-		FriendRequests: requestees[0].ID,
-	}, nil
-}
+		user = dbmodel.User{
+			ID:              dbmodel.AvailableUsername(base),
+			Email:           idToken.Email,
+			IsEmailVerified: true,
+		}
 
-func (r *mutationResolver) RejectFriendRequest(ctx context.Context, id string) (*model.User, error) {
-	var requestees []dbmodel.User
+		d = r.DB.Create(&user)
+		if d.Error != nil {
+			lib.LogError(lib.LPanic, "Could not create user", d.Error)
+		}
 
-	authedUser := dbmodel.AuthedUserFromCtx(ctx)
+		userID = user.ID
 
-	err := lib.Validator.Var(id, "username,max=64")
-	if err != nil {
-		return nil, lib.ErrValidationFailed
+		d = r.DB.Create(&dbmodel.Identity{
+			Provider: state.Provider,
+			Subject:  idToken.Subject,
+			UserID:   userID,
+			Email:    idToken.Email,
+		})
+		if d.Error != nil {
+			lib.LogError(lib.LPanic, "Could not create identity", d.Error)
+		}
 	}
 
-	d := r.DB.Model(&dbmodel.User{ID: authedUser}).Select("id, first_name, last_name").Where(
-		"requester_id = ?", id).Related(&requestees, "FriendRequests")
+	d = r.DB.Select("id, email, is_email_verified, is_two_factor_enabled, suspended_at, deletion_scheduled_at").Where("id = ?", userID).First(&user)
 	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
-		lib.LogError(lib.LPanic, "Could not read user's friend requests", d.Error)
-	}
-
-	if len(requestees) != 1 {
+		lib.LogError(lib.LPanic, "Could not read user", d.Error)
+	} else if d.RecordNotFound() {
 		return nil, dbmodel.ErrUserNotFound
 	}
 
-	err = r.DB.Model(&dbmodel.User{ID: authedUser}).Association("FriendRequests").Delete(requestees[0]).Error
-	if err != nil {
-		lib.LogError(lib.LPanic, "Could not reject friendship", err)
-	}
+	r.verifyOIDCEmail(&user, idToken)
 
-	return &model.User{
-		ID:             requestees[0].ID,
-		FirstName:      requestees[0].FirstName,
-		LastName:       requestees[0].LastName,
-		Friends:        requestees[0].ID,
-		FriendRequests: requestees[0].ID,
-	}, nil
+	return r.login(ctx, &user)
 }
 
-func (r *mutationResolver) CreateWish(ctx context.Context, input model.NewWish) (*model.Wish, error) {
+func (r *mutationResolver) LinkOidcIdentity(ctx context.Context, provider string) (string, error) {
 	authedUser := dbmodel.AuthedUserFromCtx(ctx)
 
-	err := lib.Validator.Struct(&input)
+	err := lib.Validator.Var(provider, "max=64")
 	if err != nil {
-		return nil, lib.ErrValidationFailed
-	}
-
-	wish := dbmodel.Wish{
-		Owner:       authedUser,
-		Name:        input.Name,
-		Description: input.Description,
-		Link:        input.Link,
-		Image:       input.Image,
+		return "", lib.ErrValidationFailed
 	}
 
-	d := r.DB.Create(&wish)
-	if d.Error != nil {
-		lib.LogError(lib.LPanic, "Could not create wish", d.Error)
-	}
-
-	return &model.Wish{
-		ID:                  wish.ID,
-		Owner:               authedUser,
-		Name:                wish.Name,
-		Description:         wish.Description,
-		Link:                wish.Link,
-		Image:               wish.Image,
-		FulfillmentClaimers: wish.ID,
-		Fulfillers:          wish.ID,
-	}, nil
+	return r.beginOIDC(provider, &authedUser)
 }
 
-func (r *mutationResolver) UpdateWish(ctx context.Context, input model.UpdateWish) (*model.Wish, error) {
-	var wish dbmodel.Wish
+func (r *mutationResolver) CompleteOidcLink(ctx context.Context, input model.OidcCallback) (bool, error) {
+	var identity dbmodel.Identity
+	var user dbmodel.User
 
 	authedUser := dbmodel.AuthedUserFromCtx(ctx)
 
 	err := lib.Validator.Struct(&input)
 	if err != nil {
-		return nil, lib.ErrValidationFailed
+		return false, lib.ErrValidationFailed
 	}
 
-	d := r.DB.Select("id, name, owner, description, link, image").First(&wish, input.ID)
-	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
-		lib.LogError(lib.LPanic, "Could not read wish", d.Error)
-	} else if d.RecordNotFound() {
-		return nil, dbmodel.ErrWishNotFound
+	state, err := dbmodel.ConsumeOIDCState(input.State, &authedUser)
+	if err != nil {
+		return false, err
 	}
 
-	if wish.Owner != authedUser {
-		return nil, dbmodel.ErrUserNotAuthorized
+	idToken, err := r.exchangeOIDC(state, input.Code)
+	if err != nil {
+		return false, err
 	}
 
-	d = r.DB.Model(&wish).Updates(&dbmodel.Wish{
-		Name:        input.Name,
-		Description: input.Description,
-		Link:        input.Link,
-		Image:       input.Image,
+	d := r.DB.Where("provider = ? AND subject = ?", state.Provider, idToken.Subject).First(&identity)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read identity", d.Error)
+	} else if !d.RecordNotFound() {
+		if identity.UserID != authedUser {
+			return false, dbmodel.ErrIdentityLinked
+		}
+
+		return true, nil
+	}
+
+	d = r.DB.Create(&dbmodel.Identity{
+		Provider: state.Provider,
+		Subject:  idToken.Subject,
+		UserID:   authedUser,
+		Email:    idToken.Email,
 	})
 	if d.Error != nil {
-		lib.LogError(lib.LPanic, "Could not update wish", d.Error)
-	}
-
-	return &model.Wish{
-		ID:                  wish.ID,
-		Owner:               wish.Owner,
-		Name:                wish.Name,
-		Description:         wish.Description,
-		Link:                wish.Link,
-		Image:               wish.Image,
-		FulfillmentClaimers: wish.ID,
-		Fulfillers:          wish.ID,
-	}, nil
+		lib.LogError(lib.LPanic, "Could not create identity", d.Error)
+	}
+
+	d = r.DB.Select("id, email, is_email_verified").Where("id = ?", authedUser).First(&user)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read user", d.Error)
+	} else if d.RecordNotFound() {
+		return false, dbmodel.ErrUserNotFound
+	}
+
+	r.verifyOIDCEmail(&user, idToken)
+
+	return true, nil
 }
 
-func (r *mutationResolver) DeleteWish(ctx context.Context, id int) (int, error) {
-	var wish dbmodel.Wish
+func (r *mutationResolver) UnlinkOidcIdentity(ctx context.Context, provider string) (bool, error) {
+	var user dbmodel.User
+	var count int
 
 	authedUser := dbmodel.AuthedUserFromCtx(ctx)
 
-	err := lib.Validator.Var(id, "min=0")
+	err := lib.Validator.Var(provider, "max=64")
+	if err != nil {
+		return false, lib.ErrValidationFailed
+	}
+
+	d := r.DB.Select("id, password").Where("id = ?", authedUser).First(&user)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read user", d.Error)
+	} else if d.RecordNotFound() {
+		return false, dbmodel.ErrUserNotFound
+	}
+
+	d = r.DB.Model(&dbmodel.Identity{}).Where("user_id = ?", authedUser).Count(&count)
+	if d.Error != nil {
+		lib.LogError(lib.LPanic, "Could not read identities", d.Error)
+	}
+
+	if user.Password == "" && count <= 1 {
+		return false, dbmodel.ErrLastLoginMethod
+	}
+
+	d = r.DB.Where("user_id = ? AND provider = ?", authedUser, provider).Delete(&dbmodel.Identity{})
+	if d.Error != nil {
+		lib.LogError(lib.LPanic, "Could not delete identity", d.Error)
+	}
+
+	if d.RowsAffected == 0 {
+		return false, dbmodel.ErrIdentityNotFound
+	}
+
+	return true, nil
+}
+
+func (r *mutationResolver) RefreshToken(ctx context.Context, token string) (*model.Token, error) {
+	c := lib.GinCtxFromCtx(ctx)
+
+	tokens, err := dbmodel.RefreshSession(token, c.Request.UserAgent(), c.ClientIP())
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.Token{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	}, nil
+}
+
+func (r *mutationResolver) Logout(ctx context.Context) (bool, error) {
+	authedUser := dbmodel.AuthedUserFromCtx(ctx)
+	authedSession := dbmodel.AuthedSessionFromCtx(ctx)
+
+	err := dbmodel.DeleteSession(authedUser, authedSession)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *mutationResolver) LogoutAllSessions(ctx context.Context) (bool, error) {
+	authedUser := dbmodel.AuthedUserFromCtx(ctx)
+
+	dbmodel.DeleteSessions(authedUser, "")
+
+	return true, nil
+}
+
+func (r *mutationResolver) CreateAPIToken(ctx context.Context, input model.NewApiToken) (*model.CreatedApiToken, error) {
+	var scopes []dbmodel.Scope
+	var expiresAt *time.Time
+
+	authedUser := dbmodel.AuthedUserFromCtx(ctx)
+
+	err := lib.Validator.Struct(&input)
+	if err != nil {
+		return nil, lib.ErrValidationFailed
+	}
+
+	for _, s := range input.Scopes {
+		scopes = append(scopes, dbmodel.Scope(strings.ToLower(s.String())))
+	}
+
+	if input.ExpiresInDays != nil {
+		e := time.Now().UTC().AddDate(0, 0, *input.ExpiresInDays)
+		expiresAt = &e
+	}
+
+	token, plain := dbmodel.CreateAPIToken(authedUser, input.Name, scopes, expiresAt)
+
+	return &model.CreatedApiToken{
+		Token:    plain,
+		APIToken: r.apiToken(token),
+	}, nil
+}
+
+func (r *mutationResolver) RevokeAPIToken(ctx context.Context, id int) (int, error) {
+	authedUser := dbmodel.AuthedUserFromCtx(ctx)
+
+	err := lib.Validator.Var(id, "min=0")
+	if err != nil {
+		return 0, lib.ErrValidationFailed
+	}
+
+	d := r.DB.Where("id = ? AND user_id = ?", id, authedUser).Delete(&dbmodel.APIToken{})
+	if d.Error != nil {
+		lib.LogError(lib.LPanic, "Could not delete API token", d.Error)
+	}
+
+	if d.RowsAffected == 0 {
+		return 0, dbmodel.ErrAPITokenNotFound
+	}
+
+	return id, nil
+}
+
+func (r *mutationResolver) EnableTwoFactor(ctx context.Context) (*model.TwoFactorSetup, error) {
+	var user dbmodel.User
+
+	authedUser := dbmodel.AuthedUserFromCtx(ctx)
+
+	d := r.DB.Select("id, is_two_factor_enabled").Where("id = ?", authedUser).First(&user)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read user", d.Error)
+	} else if d.RecordNotFound() {
+		return nil, dbmodel.ErrUserNotFound
+	}
+
+	if user.IsTwoFactorEnabled {
+		return nil, dbmodel.ErrTwoFactorEnabled
+	}
+
+	secret, err := lib.GenTOTPSecret()
+	if err != nil {
+		lib.LogError(lib.LPanic, "Could not generate TOTP secret", err)
+	}
+
+	d = r.DB.Model(&user).Update("two_factor_secret", secret)
+	if d.Error != nil {
+		lib.LogError(lib.LPanic, "Could not update user", d.Error)
+	}
+
+	return &model.TwoFactorSetup{
+		Secret: secret,
+		URI:    lib.TOTPURI("Wishlist", user.ID, secret),
+	}, nil
+}
+
+func (r *mutationResolver) ConfirmTwoFactor(ctx context.Context, code string) ([]string, error) {
+	var user dbmodel.User
+
+	authedUser := dbmodel.AuthedUserFromCtx(ctx)
+
+	err := lib.Validator.Var(code, "len=6,numeric")
+	if err != nil {
+		return nil, lib.ErrValidationFailed
+	}
+
+	d := r.DB.Select("id, two_factor_secret, two_factor_last_step, is_two_factor_enabled").Where(
+		"id = ?", authedUser).First(&user)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read user", d.Error)
+	} else if d.RecordNotFound() {
+		return nil, dbmodel.ErrUserNotFound
+	}
+
+	if user.IsTwoFactorEnabled {
+		return nil, dbmodel.ErrTwoFactorEnabled
+	} else if user.TwoFactorSecret == nil {
+		return nil, dbmodel.ErrTwoFactorNotSetUp
+	}
+
+	if !dbmodel.VerifyTwoFactor(&user, code) {
+		return nil, dbmodel.ErrTwoFactorCodeInvalid
+	}
+
+	d = r.DB.Model(&user).Update("is_two_factor_enabled", true)
+	if d.Error != nil {
+		lib.LogError(lib.LPanic, "Could not update user", d.Error)
+	}
+
+	return dbmodel.GenRecoveryCodes(authedUser), nil
+}
+
+func (r *mutationResolver) DisableTwoFactor(ctx context.Context, code string) (bool, error) {
+	var user dbmodel.User
+
+	authedUser := dbmodel.AuthedUserFromCtx(ctx)
+
+	err := lib.Validator.Var(code, "min=6,max=20")
+	if err != nil {
+		return false, lib.ErrValidationFailed
+	}
+
+	d := r.DB.Select("id, two_factor_secret, two_factor_last_step, is_two_factor_enabled").Where(
+		"id = ?", authedUser).First(&user)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read user", d.Error)
+	} else if d.RecordNotFound() {
+		return false, dbmodel.ErrUserNotFound
+	}
+
+	if !user.IsTwoFactorEnabled {
+		return false, dbmodel.ErrTwoFactorNotEnabled
+	}
+
+	// Only attempts that check a code are throttled
+	err = r.reserveLogin(ctx, authedUser)
+	if err != nil {
+		return false, err
+	}
+
+	if !dbmodel.VerifyTwoFactor(&user, code) {
+		return false, dbmodel.ErrTwoFactorCodeInvalid
+	}
+
+	r.releaseLogin(ctx, authedUser)
+
+	dbmodel.DisableTwoFactor(authedUser)
+
+	return true, nil
+}
+
+func (r *mutationResolver) VerifyEmail(ctx context.Context, code string) (bool, error) {
+	var user dbmodel.User
+
+	authedUser := dbmodel.AuthedUserFromCtx(ctx)
+
+	err := lib.Validator.Var(code, "max=14")
+	if err != nil {
+		return false, lib.ErrValidationFailed
+	}
+
+	d := r.DB.Select("is_email_verified").Where("id = ?", authedUser).First(&user)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read user", d.Error)
+	} else if d.RecordNotFound() {
+		return false, dbmodel.ErrUserNotFound
+	}
+
+	if user.IsEmailVerified {
+		return false, dbmodel.ErrEmailVerified
+	}
+
+	isMatch, err := dbmodel.VerifyCode(authedUser, dbmodel.CodeEmailVerification, code)
+	if err != nil {
+		return false, err
+	}
+
+	if isMatch.View.(bool) {
+		d := db.DB.Model(&dbmodel.User{ID: authedUser}).Update("is_email_verified", true)
+		if d.Error != nil {
+			lib.LogError(lib.LPanic, "Could not update user", d.Error)
+		}
+	}
+
+	return true, nil
+}
+
+func (r *mutationResolver) ResendVerificationEmail(ctx context.Context) (bool, error) {
+	var user dbmodel.User
+
+	authedUser := dbmodel.AuthedUserFromCtx(ctx)
+
+	d := r.DB.Select("id, email, is_email_verified").Where("id = ?", authedUser).First(&user)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read user", d.Error)
+	} else if d.RecordNotFound() {
+		return false, dbmodel.ErrUserNotFound
+	}
+
+	if user.IsEmailVerified {
+		return false, dbmodel.ErrEmailVerified
+	}
+
+	err := r.sendEmailConfirmMail(&user)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *mutationResolver) RequestEmailChange(ctx context.Context, newEmail string) (bool, error) {
+	var user dbmodel.User
+	var count int
+
+	authedUser := dbmodel.AuthedUserFromCtx(ctx)
+
+	err := lib.Validator.Var(newEmail, "email,max=254")
+	if err != nil {
+		return false, lib.ErrValidationFailed
+	}
+
+	d := r.DB.Model(&dbmodel.User{}).Where("email = ?", newEmail).Count(&count)
+	if d.Error != nil {
+		lib.LogError(lib.LPanic, "Could not read user", d.Error)
+	}
+
+	if count != 0 {
+		return false, dbmodel.ErrUserExists
+	}
+
+	d = r.DB.Select("id, email").Where("id = ?", authedUser).First(&user)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read user", d.Error)
+	} else if d.RecordNotFound() {
+		return false, dbmodel.ErrUserNotFound
+	}
+
+	err = r.sendEmailChangeMail(&user, newEmail)
+	if err != nil {
+		return false, err
+	}
+
+	d = r.DB.Model(&user).Update("pending_email", newEmail)
+	if d.Error != nil {
+		lib.LogError(lib.LPanic, "Could not update user", d.Error)
+	}
+
+	return true, nil
+}
+
+func (r *mutationResolver) ConfirmEmailChange(ctx context.Context, code string) (bool, error) {
+	var user dbmodel.User
+	var count int
+
+	authedUser := dbmodel.AuthedUserFromCtx(ctx)
+
+	err := lib.Validator.Var(code, "max=14")
+	if err != nil {
+		return false, lib.ErrValidationFailed
+	}
+
+	d := r.DB.Select("id, email, pending_email").Where("id = ?", authedUser).First(&user)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read user", d.Error)
+	} else if d.RecordNotFound() {
+		return false, dbmodel.ErrUserNotFound
+	}
+
+	if user.PendingEmail == nil {
+		return false, dbmodel.ErrCodeNotFound
+	}
+
+	isMatch, err := dbmodel.VerifyCode(authedUser, dbmodel.CodeEmailChange, code)
+	if err != nil {
+		return false, err
+	}
+
+	if !isMatch.View.(bool) {
+		return false, dbmodel.ErrCodeNotMatch
+	}
+
+	newEmail := *user.PendingEmail
+
+	d = r.DB.Model(&dbmodel.User{}).Where("email = ?", newEmail).Count(&count)
+	if d.Error != nil {
+		lib.LogError(lib.LPanic, "Could not read user", d.Error)
+	}
+
+	if count != 0 {
+		return false, dbmodel.ErrUserExists
+	}
+
+	oldUser := user
+
+	d = r.DB.Model(&user).Updates(map[string]interface{}{
+		"email":             newEmail,
+		"pending_email":     nil,
+		"is_email_verified": true,
+	})
+	if d.Error != nil {
+		lib.LogError(lib.LPanic, "Could not update user", d.Error)
+	}
+
+	// Access tokens carry user's email address, revoking the sessions makes
+	// sure that the ones minted with the old address can not be refreshed
+	dbmodel.DeleteSessions(authedUser, "")
+
+	r.sendEmailChangedMail(&oldUser, newEmail)
+
+	return true, nil
+}
+
+func (r *mutationResolver) ChangePassword(ctx context.Context, input model.ChangePassword) (bool, error) {
+	var user dbmodel.User
+
+	authedUser := dbmodel.AuthedUserFromCtx(ctx)
+	authedSession := dbmodel.AuthedSessionFromCtx(ctx)
+
+	err := lib.Validator.Struct(&input)
+	if err != nil {
+		return false, lib.ErrValidationFailed
+	}
+
+	d := r.DB.Select("id, password").Where("id = ?", authedUser).First(&user)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read user", d.Error)
+	} else if d.RecordNotFound() {
+		return false, dbmodel.ErrUserNotFound
+	}
+
+	if !dbmodel.VerifyPassword(input.CurrentPassword, user.Password) {
+		return false, dbmodel.ErrUnmOrPwdIncorrect
+	}
+
+	d = r.DB.Model(&user).Update("password", dbmodel.GenPasswordHash(input.NewPassword))
+	if d.Error != nil {
+		lib.LogError(lib.LPanic, "Could not update user", d.Error)
+	}
+
+	dbmodel.DeleteSessions(authedUser, authedSession)
+
+	return true, nil
+}
+
+func (r *mutationResolver) RequestPasswordReset(ctx context.Context, email string) (bool, error) {
+	var user dbmodel.User
+
+	err := lib.Validator.Var(email, "email")
+	if err != nil {
+		return false, lib.ErrValidationFailed
+	}
+
+	d := r.DB.Select("id, email").Where("email = ?", email).First(&user)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read user", d.Error)
+	} else if d.RecordNotFound() {
+		// Unknown emails are not reported so that they can not be used
+		// to find out who has an account
+		return true, nil
+	}
+
+	// Mail is sent in the background so that response time does not
+	// reveal whether the email belongs to an account
+	go func() {
+		// Panics are not recovered by gin outside of the request's goroutine
+		defer func() {
+			if e := recover(); e != nil {
+				lib.LogError(lib.LError, "Could not send password reset mail", fmt.Errorf("%v", e))
+			}
+		}()
+
+		err := r.sendPasswordResetMail(&user)
+		if err != nil {
+			lib.LogError(lib.LError, "Could not send password reset mail", err)
+		}
+	}()
+
+	return true, nil
+}
+
+func (r *mutationResolver) ResetPassword(ctx context.Context, input model.PasswordReset) (bool, error) {
+	var user dbmodel.User
+
+	err := lib.Validator.Struct(&input)
+	if err != nil {
+		return false, lib.ErrValidationFailed
+	}
+
+	d := r.DB.Select("id").Where("id = ?", input.ID).First(&user)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read user", d.Error)
+	} else if d.RecordNotFound() {
+		return false, dbmodel.ErrUserNotFound
+	}
+
+	isMatch, err := dbmodel.VerifyCode(user.ID, dbmodel.CodePasswordReset, input.Code)
+	if err != nil {
+		return false, err
+	}
+
+	if isMatch.View.(bool) {
+		d := r.DB.Model(&dbmodel.User{ID: user.ID}).Update("password", dbmodel.GenPasswordHash(input.NewPassword))
+		if d.Error != nil {
+			lib.LogError(lib.LPanic, "Could not update user", d.Error)
+		}
+
+		dbmodel.DeleteSessions(user.ID, "")
+	}
+
+	return true, nil
+}
+
+func (r *mutationResolver) SendFriendRequest(ctx context.Context, id string) (*model.User, error) {
+	var requestee dbmodel.User
+	var friendsCount uint8
+	var friendRequestsCount uint8
+
+	authedUser := dbmodel.AuthedUserFromCtx(ctx)
+
+	err := lib.Validator.Var(id, "username,max=64")
+	if err != nil {
+		return nil, lib.ErrValidationFailed
+	}
+
+	if authedUser == id {
+		return nil, dbmodel.ErrUserNotFound
+	}
+
+	d := r.DB.Select("id, first_name, last_name").Where("id = ?", id).First(&requestee)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read user", d.Error)
+	} else if d.RecordNotFound() {
+		return nil, dbmodel.ErrUserNotFound
+	}
+
+	d = r.DB.Table("friendrequests").Where("user_id = ? AND requester_id = ?", id, authedUser).Count(&friendRequestsCount)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read user", d.Error)
+	}
+
+	d = r.DB.Table("friendships").Where("user_id = ? AND friend_id = ?", authedUser, id).Count(&friendsCount)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read user", d.Error)
+	}
+
+	if friendRequestsCount != 0 || friendsCount != 0 {
+		return nil, dbmodel.ErrUserExists
+	}
+
+	err = r.DB.Model(&dbmodel.User{ID: id}).Association("FriendRequests").Append(&dbmodel.User{ID: authedUser}).Error
+	if err != nil {
+		lib.LogError(lib.LPanic, "Could not request friendship", err)
+	}
+
+	return &model.User{
+		ID:             requestee.ID,
+		FirstName:      requestee.FirstName,
+		LastName:       requestee.LastName,
+		Friends:        requestee.ID,
+		FriendRequests: requestee.ID,
+	}, nil
+}
+
+func (r *mutationResolver) UnSendFriendRequest(ctx context.Context, id string) (*model.User, error) {
+	var requestees []dbmodel.User
+
+	authedUser := dbmodel.AuthedUserFromCtx(ctx)
+
+	err := lib.Validator.Var(id, "username,max=64")
+	if err != nil {
+		return nil, lib.ErrValidationFailed
+	}
+
+	err = r.DB.Model(&dbmodel.User{ID: id}).Select("id, first_name, last_name").Where("requester_id = ?", authedUser).Association("FriendRequests").Find(&requestees).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return nil, dbmodel.ErrUserNotFound
+	} else if err != nil {
+		lib.LogError(lib.LPanic, "Could not delete friendship request", err)
+	}
+
+	err = r.DB.Model(&dbmodel.User{ID: id}).Association("FriendRequests").Delete(&dbmodel.User{ID: authedUser}).Error
+	if err != nil {
+		lib.LogError(lib.LPanic, "Could not delete friendship request", err)
+	}
+
+	return &model.User{
+		ID:             requestees[0].ID,
+		FirstName:      requestees[0].FirstName,
+		LastName:       requestees[0].LastName,
+		Friends:        requestees[0].ID,
+		FriendRequests: requestees[0].ID,
+	}, nil
+}
+
+func (r *mutationResolver) AcceptFriendRequest(ctx context.Context, id string) (*model.User, error) {
+	var requestees []dbmodel.User
+
+	authedUser := dbmodel.AuthedUserFromCtx(ctx)
+
+	err := lib.Validator.Var(id, "username,max=64")
+	if err != nil {
+		return nil, lib.ErrValidationFailed
+	}
+
+	d := r.DB.Model(&dbmodel.User{ID: authedUser}).Select("id, first_name, last_name").Where(
+		"requester_id = ?", id).Related(&requestees, "FriendRequests")
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read user's friend requests", d.Error)
+	}
+
+	if len(requestees) != 1 {
+		return nil, dbmodel.ErrUserNotFound
+	}
+
+	err = r.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&dbmodel.User{ID: authedUser}).Association("Friends").Append(requestees[0]).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&dbmodel.User{ID: requestees[0].ID}).Association("Friends").Append(&dbmodel.User{ID: authedUser}).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&dbmodel.User{ID: authedUser}).Association("FriendRequests").Delete(requestees[0]).Error
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		lib.LogError(lib.LPanic, " Could not accept friendship", err)
+	}
+
+	return &model.User{
+		ID:             requestees[0].ID,
+		FirstName:      requestees[0].FirstName,
+		LastName:       requestees[0].LastName,
+		Friends:        requestees[0].ID,
+		FriendRequests: requestees[0].ID,
+	}, nil
+}
+
+func (r *mutationResolver) RejectFriendRequest(ctx context.Context, id string) (*model.User, error) {
+	var requestees []dbmodel.User
+
+	authedUser := dbmodel.AuthedUserFromCtx(ctx)
+
+	err := lib.Validator.Var(id, "username,max=64")
+	if err != nil {
+		return nil, lib.ErrValidationFailed
+	}
+
+	d := r.DB.Model(&dbmodel.User{ID: authedUser}).Select("id, first_name, last_name").Where(
+		"requester_id = ?", id).Related(&requestees, "FriendRequests")
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read user's friend requests", d.Error)
+	}
+
+	if len(requestees) != 1 {
+		return nil, dbmodel.ErrUserNotFound
+	}
+
+	err = r.DB.Model(&dbmodel.User{ID: authedUser}).Association("FriendRequests").Delete(requestees[0]).Error
+	if err != nil {
+		lib.LogError(lib.LPanic, "Could not reject friendship", err)
+	}
+
+	return &model.User{
+		ID:             requestees[0].ID,
+		FirstName:      requestees[0].FirstName,
+		LastName:       requestees[0].LastName,
+		Friends:        requestees[0].ID,
+		FriendRequests: requestees[0].ID,
+	}, nil
+}
+
+func (r *mutationResolver) CreateWish(ctx context.Context, input model.NewWish) (*model.Wish, error) {
+	authedUser := dbmodel.AuthedUserFromCtx(ctx)
+
+	err := lib.Validator.Struct(&input)
+	if err != nil {
+		return nil, lib.ErrValidationFailed
+	}
+
+	if input.WishlistID != nil {
+		err = r.checkWishlist(ctx, *input.WishlistID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	wish := dbmodel.Wish{
+		Owner:       authedUser,
+		WishlistID:  input.WishlistID,
+		Position:    input.Position,
+		Name:        input.Name,
+		Description: input.Description,
+		Link:        input.Link,
+		Image:       input.Image,
+		Quantity:    input.Quantity,
+		Visibility:  dbmodel.Visibility(strings.ToLower(input.Visibility.String())),
+		Surprise:    input.Surprise,
+		RevealAt:    input.RevealAt,
+	}
+
+	err = r.DB.Transaction(func(tx *gorm.DB) error {
+		d := tx.Create(&wish)
+		if d.Error != nil {
+			lib.LogError(lib.LPanic, "Could not create wish", d.Error)
+		}
+
+		return dbmodel.SetAudience(tx, &wish, input.Audience)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.wishModel(&wish), nil
+}
+
+func (r *mutationResolver) UpdateWish(ctx context.Context, input model.UpdateWish) (*model.Wish, error) {
+	var wish dbmodel.Wish
+
+	err := lib.Validator.Struct(&input)
+	if err != nil {
+		return nil, lib.ErrValidationFailed
+	}
+
+	d := r.DB.Select(dbmodel.WishColumns).First(&wish, input.ID)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read wish", d.Error)
+	} else if d.RecordNotFound() {
+		return nil, dbmodel.ErrWishNotFound
+	}
+
+	if !r.Enforcer.Allowed(r.subject(ctx), &wish, policy.Owner) {
+		return nil, dbmodel.ErrUserNotAuthorized
+	}
+
+	if input.RemoveFromWishlist {
+		input.WishlistID = nil
+	} else if input.WishlistID != nil {
+		err = r.checkWishlist(ctx, *input.WishlistID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if input.ClearRevealAt {
+		input.RevealAt = nil
+	}
+
+	update := dbmodel.Wish{
+		WishlistID:  input.WishlistID,
+		Name:        input.Name,
+		Description: input.Description,
+		Link:        input.Link,
+		Image:       input.Image,
+		RevealAt:    input.RevealAt,
+	}
+	if input.Visibility != nil {
+		update.Visibility = dbmodel.Visibility(strings.ToLower(input.Visibility.String()))
+	}
+	if input.Quantity != nil {
+		update.Quantity = *input.Quantity
+	}
+
+	err = r.DB.Transaction(func(tx *gorm.DB) error {
+		// Wish is locked so that claims can not be made between checking
+		// them and updating the wish
+		d := tx.Set("gorm:query_option", "FOR UPDATE").Select(dbmodel.WishColumns).First(&wish, wish.ID)
+		if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+			lib.LogError(lib.LPanic, "Could not read wish", d.Error)
+		} else if d.RecordNotFound() {
+			return dbmodel.ErrWishNotFound
+		}
+
+		if input.Quantity != nil && *input.Quantity < dbmodel.ClaimedQuantity(tx, wish.ID) {
+			return dbmodel.ErrQuantityBelowClaimed
+		}
+
+		// Owner must not reveal the claims of a concealed wish early
+		if wish.Concealed() && dbmodel.IsReserved(wish.ID) {
+			disabled := input.Surprise != nil && !*input.Surprise
+			earlier := input.RevealAt != nil && (wish.RevealAt == nil || input.RevealAt.Before(*wish.RevealAt))
+
+			if disabled || earlier {
+				return dbmodel.ErrWishConcealed
+			}
+		}
+
+		d = tx.Model(&wish).Updates(&update)
+		if d.Error != nil {
+			lib.LogError(lib.LPanic, "Could not update wish", d.Error)
+		}
+
+		// Updates skips zero values so these are updated separately
+		if input.Surprise != nil {
+			d = tx.Model(&wish).Update("surprise", *input.Surprise)
+			if d.Error != nil {
+				lib.LogError(lib.LPanic, "Could not update wish", d.Error)
+			}
+		}
+
+		if input.Position != nil {
+			d = tx.Model(&wish).Update("position", *input.Position)
+			if d.Error != nil {
+				lib.LogError(lib.LPanic, "Could not update wish", d.Error)
+			}
+		}
+
+		if input.RemoveFromWishlist {
+			d = tx.Model(&wish).Update("wishlist_id", gorm.Expr("NULL"))
+			if d.Error != nil {
+				lib.LogError(lib.LPanic, "Could not update wish", d.Error)
+			}
+
+			wish.WishlistID = nil
+		}
+
+		if input.ClearRevealAt {
+			d = tx.Model(&wish).Update("reveal_at", gorm.Expr("NULL"))
+			if d.Error != nil {
+				lib.LogError(lib.LPanic, "Could not update wish", d.Error)
+			}
+
+			wish.RevealAt = nil
+		}
+
+		if input.Audience == nil {
+			return nil
+		}
+
+		return dbmodel.SetAudience(tx, &wish, input.Audience)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.wishModel(&wish), nil
+}
+
+func (r *mutationResolver) DeleteWish(ctx context.Context, id int) (int, error) {
+	var wish dbmodel.Wish
+
+	err := lib.Validator.Var(id, "min=0")
 	if err != nil {
 		return 0, lib.ErrValidationFailed
 	}
@@ -425,21 +1264,327 @@ func (r *mutationResolver) DeleteWish(ctx context.Context, id int) (int, error)
 	} else if d.RecordNotFound() {
 		return 0, dbmodel.ErrWishNotFound
 	}
-	if wish.Owner != authedUser {
+
+	if !r.Enforcer.Allowed(r.subject(ctx), &wish, policy.Owner) {
+		return 0, dbmodel.ErrUserNotAuthorized
+	}
+
+	d = r.DB.Delete(wish)
+	if d.Error != nil {
+		lib.LogError(lib.LPanic, "Could not delete wish", d.Error)
+	}
+
+	return wish.ID, nil
+}
+
+func (r *mutationResolver) CreateWishlist(ctx context.Context, input model.NewWishlist) (*model.Wishlist, error) {
+	authedUser := dbmodel.AuthedUserFromCtx(ctx)
+
+	err := lib.Validator.Struct(&input)
+	if err != nil {
+		return nil, lib.ErrValidationFailed
+	}
+
+	wishlist := dbmodel.Wishlist{
+		Owner:       authedUser,
+		Name:        input.Name,
+		Description: input.Description,
+		Visibility:  dbmodel.Visibility(strings.ToLower(input.Visibility.String())),
+		Position:    input.Position,
+	}
+
+	d := r.DB.Create(&wishlist)
+	if d.Error != nil {
+		lib.LogError(lib.LPanic, "Could not create wishlist", d.Error)
+	}
+
+	return r.wishlistModel(&wishlist), nil
+}
+
+func (r *mutationResolver) UpdateWishlist(ctx context.Context, input model.UpdateWishlist) (*model.Wishlist, error) {
+	var wishlist dbmodel.Wishlist
+
+	err := lib.Validator.Struct(&input)
+	if err != nil {
+		return nil, lib.ErrValidationFailed
+	}
+
+	d := r.DB.Select(dbmodel.WishlistColumns).First(&wishlist, input.ID)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read wishlist", d.Error)
+	} else if d.RecordNotFound() {
+		return nil, dbmodel.ErrWishlistNotFound
+	}
+
+	if !r.Enforcer.Allowed(r.subject(ctx), &wishlist, policy.Owner) {
+		return nil, dbmodel.ErrUserNotAuthorized
+	}
+
+	update := dbmodel.Wishlist{
+		Name:        input.Name,
+		Description: input.Description,
+	}
+	if input.Visibility != nil {
+		update.Visibility = dbmodel.Visibility(strings.ToLower(input.Visibility.String()))
+	}
+
+	err = r.DB.Transaction(func(tx *gorm.DB) error {
+		d := tx.Model(&wishlist).Updates(&update)
+		if d.Error != nil {
+			return d.Error
+		}
+
+		// Updates skips zero values so position is updated separately
+		if input.Position != nil {
+			return tx.Model(&wishlist).Update("position", *input.Position).Error
+		}
+
+		return nil
+	})
+	if err != nil {
+		lib.LogError(lib.LPanic, "Could not update wishlist", err)
+	}
+
+	return r.wishlistModel(&wishlist), nil
+}
+
+func (r *mutationResolver) DeleteWishlist(ctx context.Context, id int) (int, error) {
+	var wishlist dbmodel.Wishlist
+
+	err := lib.Validator.Var(id, "min=0")
+	if err != nil {
+		return 0, lib.ErrValidationFailed
+	}
+
+	d := r.DB.Select("id, owner").First(&wishlist, id)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read wishlist", d.Error)
+	} else if d.RecordNotFound() {
+		return 0, dbmodel.ErrWishlistNotFound
+	}
+
+	if !r.Enforcer.Allowed(r.subject(ctx), &wishlist, policy.Owner) {
+		return 0, dbmodel.ErrUserNotAuthorized
+	}
+
+	err = r.DB.Transaction(func(tx *gorm.DB) error {
+		return dbmodel.DeleteWishlist(tx, wishlist.ID)
+	})
+	if err != nil {
+		lib.LogError(lib.LPanic, "Could not delete wishlist", err)
+	}
+
+	return wishlist.ID, nil
+}
+
+func (r *mutationResolver) CreateOccasion(ctx context.Context, input model.NewOccasion) (*model.Occasion, error) {
+	authedUser := dbmodel.AuthedUserFromCtx(ctx)
+
+	err := lib.Validator.Struct(&input)
+	if err != nil {
+		return nil, lib.ErrValidationFailed
+	}
+
+	if input.WishlistID != nil {
+		err = r.checkWishlist(ctx, *input.WishlistID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	occasion := dbmodel.Occasion{
+		Owner:            authedUser,
+		WishlistID:       input.WishlistID,
+		Kind:             dbmodel.OccasionKind(strings.ToLower(input.Kind.String())),
+		Name:             input.Name,
+		Date:             calendarDate(input.Date),
+		RemindDaysBefore: input.RemindDaysBefore,
+	}
+
+	d := r.DB.Create(&occasion)
+	if d.Error != nil {
+		lib.LogError(lib.LPanic, "Could not create occasion", d.Error)
+	}
+
+	return r.occasionModel(&occasion), nil
+}
+
+func (r *mutationResolver) UpdateOccasion(ctx context.Context, input model.UpdateOccasion) (*model.Occasion, error) {
+	var occasion dbmodel.Occasion
+
+	err := lib.Validator.Struct(&input)
+	if err != nil {
+		return nil, lib.ErrValidationFailed
+	}
+
+	d := r.DB.Select(dbmodel.OccasionColumns).First(&occasion, input.ID)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read occasion", d.Error)
+	} else if d.RecordNotFound() {
+		return nil, dbmodel.ErrOccasionNotFound
+	}
+
+	if !r.Enforcer.Allowed(r.subject(ctx), &occasion, policy.Owner) {
+		return nil, dbmodel.ErrUserNotAuthorized
+	}
+
+	if input.WishlistID != nil {
+		err = r.checkWishlist(ctx, *input.WishlistID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	update := dbmodel.Occasion{
+		WishlistID: input.WishlistID,
+		Name:       input.Name,
+	}
+	if input.Kind != nil {
+		update.Kind = dbmodel.OccasionKind(strings.ToLower(input.Kind.String()))
+	}
+	if input.Date != nil {
+		update.Date = calendarDate(*input.Date)
+	}
+
+	err = r.DB.Transaction(func(tx *gorm.DB) error {
+		d := tx.Model(&occasion).Updates(&update)
+		if d.Error != nil {
+			return d.Error
+		}
+
+		// Updates skips zero values so this is updated separately
+		if input.RemindDaysBefore != nil {
+			return tx.Model(&occasion).Update("remind_days_before", *input.RemindDaysBefore).Error
+		}
+
+		return nil
+	})
+	if err != nil {
+		lib.LogError(lib.LPanic, "Could not update occasion", err)
+	}
+
+	return r.occasionModel(&occasion), nil
+}
+
+func (r *mutationResolver) DeleteOccasion(ctx context.Context, id int) (int, error) {
+	var occasion dbmodel.Occasion
+
+	err := lib.Validator.Var(id, "min=0")
+	if err != nil {
+		return 0, lib.ErrValidationFailed
+	}
+
+	d := r.DB.Select("id, owner").First(&occasion, id)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read occasion", d.Error)
+	} else if d.RecordNotFound() {
+		return 0, dbmodel.ErrOccasionNotFound
+	}
+
+	if !r.Enforcer.Allowed(r.subject(ctx), &occasion, policy.Owner) {
 		return 0, dbmodel.ErrUserNotAuthorized
 	}
 
-	d = r.DB.Delete(wish)
-	if d.Error != nil {
-		lib.LogError(lib.LPanic, "Could not delete wish", d.Error)
+	d = r.DB.Delete(&occasion)
+	if d.Error != nil {
+		lib.LogError(lib.LPanic, "Could not delete occasion", d.Error)
+	}
+
+	return occasion.ID, nil
+}
+
+func (r *mutationResolver) AddWantToFulfill(ctx context.Context, id int) (*model.Wish, error) {
+	authedUser := dbmodel.AuthedUserFromCtx(ctx)
+
+	err := lib.Validator.Var(id, "min=0")
+	if err != nil {
+		return nil, lib.ErrValidationFailed
+	}
+
+	wish, err := r.visibleWish(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !r.Enforcer.Allowed(r.subject(ctx), wish, policy.Friend) {
+		return nil, dbmodel.ErrUserNotAuthorized
+	}
+
+	asso := r.DB.Model(&dbmodel.Wish{ID: id}).Where("user_id = ?", authedUser).Association("WantToFulfill")
+
+	if asso.Count() != 0 {
+		return nil, dbmodel.ErrUserExists
+	}
+
+	err = r.DB.Model(&dbmodel.Wish{ID: id}).Association("WantToFulfill").Append(&dbmodel.User{ID: authedUser}).Error
+	if err != nil {
+		lib.LogError(lib.LPanic, "Could not add to WantToFulfill", err)
+	}
+
+	return r.wishModel(wish), nil
+}
+
+func (r *mutationResolver) ClaimFulfillment(ctx context.Context, id int, quantity int) (*model.Wish, error) {
+	authedUser := dbmodel.AuthedUserFromCtx(ctx)
+
+	err := lib.Validator.Struct(struct {
+		ID       int `validate:"min=0"`
+		Quantity int `validate:"min=1,max=1000"`
+	}{ID: id, Quantity: quantity})
+	if err != nil {
+		return nil, lib.ErrValidationFailed
+	}
+
+	wish, err := r.visibleWish(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// User is checked to be in WantToFulfill by MoveClaim after the wish is
+	// locked, so that the same want can not be claimed twice concurrently
+	err = r.DB.Transaction(func(tx *gorm.DB) error {
+		var locked dbmodel.Wish
+
+		// Wish is locked so that concurrent claims can not exceed its quantity
+		d := tx.Set("gorm:query_option", "FOR UPDATE").Select("id, quantity").First(&locked, id)
+		if d.Error != nil {
+			return d.Error
+		}
+
+		if quantity > dbmodel.RemainingQuantity(tx, &locked) {
+			return dbmodel.ErrQuantityExceeded
+		}
+
+		return dbmodel.MoveClaim(tx, id, authedUser, dbmodel.WishWantToFulfillAsso, dbmodel.WishClaimersAsso, quantity)
+	})
+	if err == dbmodel.ErrQuantityExceeded || err == dbmodel.ErrUserNotFound {
+		return nil, err
+	} else if err != nil {
+		lib.LogError(lib.LPanic, "Could not add to Claimers", err)
+	}
+
+	return r.wishModel(wish), nil
+}
+
+func (r *mutationResolver) AcceptFulfillmentClaim(ctx context.Context, input model.FulfillmentClaimer) (*model.Wish, error) {
+	err := lib.Validator.Struct(&input)
+	if err != nil {
+		return nil, lib.ErrValidationFailed
+	}
+
+	return r.handleClaimer(ctx, input.WishID, input.ClaimerID, dbmodel.WishFulFillersAsso)
+}
+
+func (r *mutationResolver) RejectFulfillmentClaim(ctx context.Context, input model.FulfillmentClaimer) (*model.Wish, error) {
+	err := lib.Validator.Struct(&input)
+	if err != nil {
+		return nil, lib.ErrValidationFailed
 	}
 
-	return wish.ID, nil
+	return r.handleClaimer(ctx, input.WishID, input.ClaimerID, dbmodel.WishWantToFulfillAsso)
 }
 
-func (r *mutationResolver) AddWantToFulfill(ctx context.Context, id int) (*model.Wish, error) {
-	var wish dbmodel.Wish
-
+func (r *mutationResolver) MarkFulfilled(ctx context.Context, id int) (*model.Wish, error) {
 	authedUser := dbmodel.AuthedUserFromCtx(ctx)
 
 	err := lib.Validator.Var(id, "min=0")
@@ -447,111 +1592,264 @@ func (r *mutationResolver) AddWantToFulfill(ctx context.Context, id int) (*model
 		return nil, lib.ErrValidationFailed
 	}
 
-	d := r.DB.Select("id, name, owner, description, link, image").First(&wish, id)
-	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
-		lib.LogError(lib.LPanic, "Could not read wish", d.Error)
-	} else if d.RecordNotFound() {
-		return nil, dbmodel.ErrWishNotFound
+	wish, err := r.visibleWish(ctx, id)
+	if err != nil {
+		return nil, err
 	}
 
-	if authedUser == wish.Owner || !dbmodel.AreFriends(wish.Owner, authedUser) {
-		return nil, dbmodel.ErrUserNotAuthorized
+	// Owner does not approve claims of surprise wishes so claimers mark
+	// their own claims as fulfilled
+	if !wish.Surprise {
+		return nil, dbmodel.ErrWishNotSurprise
 	}
 
-	asso := r.DB.Model(&dbmodel.Wish{ID: id}).Where("user_id = ?", authedUser).Association("WantToFulfill")
+	asso := r.DB.Model(wish).Where("user_id = ?", authedUser).Association(string(dbmodel.WishClaimersAsso))
+	if asso.Error != nil && !gorm.IsRecordNotFoundError(asso.Error) {
+		lib.LogError(lib.LPanic, "Could not read wish's claimers", asso.Error)
+	}
 
-	if asso.Count() != 0 {
-		return nil, dbmodel.ErrUserExists
+	if asso.Count() != 1 {
+		return nil, dbmodel.ErrUserNotFound
 	}
 
-	err = r.DB.Model(&dbmodel.Wish{ID: id}).Association("WantToFulfill").Append(&dbmodel.User{ID: authedUser}).Error
+	err = r.DB.Transaction(func(tx *gorm.DB) error {
+		quantity := dbmodel.ClaimQuantity(tx, dbmodel.WishClaimersAsso, wish.ID, authedUser)
+
+		return dbmodel.MoveClaim(tx, wish.ID, authedUser, dbmodel.WishClaimersAsso, dbmodel.WishFulFillersAsso, quantity)
+	})
+	if err == dbmodel.ErrUserNotFound {
+		return nil, err
+	} else if err != nil {
+		lib.LogError(lib.LPanic, "Could not add to Fulfillers", err)
+	}
+
+	return r.wishModel(wish), nil
+}
+
+func (r *mutationResolver) ReportWish(ctx context.Context, input model.WishReport) (*model.Report, error) {
+	err := lib.Validator.Struct(&input)
 	if err != nil {
-		lib.LogError(lib.LPanic, "Could not add to WantToFulfill", err)
+		return nil, lib.ErrValidationFailed
 	}
 
-	return &model.Wish{
-		ID:                  wish.ID,
-		Owner:               wish.Owner,
-		Name:                wish.Name,
-		Description:         wish.Description,
-		Link:                wish.Link,
-		Image:               wish.Image,
-		FulfillmentClaimers: wish.ID,
-		Fulfillers:          wish.ID,
-	}, nil
+	wish, err := r.visibleWish(ctx, input.WishID)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.createReport(ctx, &dbmodel.Report{
+		WishID: &wish.ID,
+		Reason: input.Reason,
+	})
 }
 
-func (r *mutationResolver) ClaimFulfillment(ctx context.Context, id int) (*model.Wish, error) {
-	var wish dbmodel.Wish
+func (r *mutationResolver) ReportUser(ctx context.Context, input model.UserReport) (*model.Report, error) {
+	var user dbmodel.User
 
 	authedUser := dbmodel.AuthedUserFromCtx(ctx)
 
-	err := lib.Validator.Var(id, "min=0")
+	err := lib.Validator.Struct(&input)
 	if err != nil {
 		return nil, lib.ErrValidationFailed
 	}
 
-	d := r.DB.Select("id, name, owner, description, link, image").First(&wish, id)
+	if input.UserID == authedUser {
+		return nil, dbmodel.ErrUserNotAuthorized
+	}
+
+	d := r.DB.Select("id").Where("id = ?", input.UserID).First(&user)
 	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
-		lib.LogError(lib.LPanic, "Could not read wish", d.Error)
+		lib.LogError(lib.LPanic, "Could not read user", d.Error)
 	} else if d.RecordNotFound() {
-		return nil, dbmodel.ErrWishNotFound
+		return nil, dbmodel.ErrUserNotFound
 	}
 
-	asso := r.DB.Model(&dbmodel.Wish{ID: id}).Where("user_id = ?", authedUser).Association("WantToFulfill")
-	if asso.Error != nil && !gorm.IsRecordNotFoundError(asso.Error) {
-		lib.LogError(lib.LPanic, "Could not read wish's WantToFulfill", asso.Error)
+	return r.createReport(ctx, &dbmodel.Report{
+		ReportedUserID: &user.ID,
+		Reason:         input.Reason,
+	})
+}
+
+func (r *mutationResolver) SuspendUser(ctx context.Context, input model.UserModeration) (bool, error) {
+	err := r.moderateUser(ctx, input, dbmodel.ActionSuspendUser, func(tx *gorm.DB, user *dbmodel.User) error {
+		if user.SuspendedAt != nil {
+			return dbmodel.ErrUserSuspended
+		}
+
+		d := tx.Model(user).Update("suspended_at", time.Now().UTC())
+		if d.Error != nil {
+			lib.LogError(lib.LPanic, "Could not suspend user", d.Error)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return false, err
 	}
 
-	if asso.Count() != 1 {
-		return nil, dbmodel.ErrUserNotFound
+	dbmodel.DeleteSessions(input.ID, "")
+
+	return true, nil
+}
+
+func (r *mutationResolver) UnsuspendUser(ctx context.Context, input model.UserModeration) (bool, error) {
+	err := r.moderateUser(ctx, input, dbmodel.ActionUnsuspendUser, func(tx *gorm.DB, user *dbmodel.User) error {
+		if user.SuspendedAt == nil {
+			return dbmodel.ErrUserNotSuspended
+		}
+
+		d := tx.Model(user).Update("suspended_at", gorm.Expr("NULL"))
+		if d.Error != nil {
+			lib.LogError(lib.LPanic, "Could not unsuspend user", d.Error)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return false, err
 	}
 
-	err = r.DB.Transaction(func(tx *gorm.DB) error {
-		asso := tx.Model(&dbmodel.Wish{ID: id}).Association("Claimers").Append(&dbmodel.User{ID: authedUser})
-		if asso.Error != nil {
-			return asso.Error
+	return true, nil
+}
+
+func (r *mutationResolver) ForceVerifyEmail(ctx context.Context, input model.UserModeration) (bool, error) {
+	err := r.moderateUser(ctx, input, dbmodel.ActionForceVerifyEmail, func(tx *gorm.DB, user *dbmodel.User) error {
+		if user.IsEmailVerified {
+			return dbmodel.ErrEmailVerified
+		}
+
+		d := tx.Model(user).Update("is_email_verified", true)
+		if d.Error != nil {
+			lib.LogError(lib.LPanic, "Could not update user", d.Error)
 		}
 
-		asso = tx.Model(&dbmodel.Wish{ID: id}).Association("WantToFulfill").Delete(&dbmodel.User{ID: authedUser})
-		if asso.Error != nil {
-			return asso.Error
+		d = tx.Where("user_id = ? AND purpose = ?", user.ID, dbmodel.CodeEmailVerification).Delete(&dbmodel.Code{})
+		if d.Error != nil {
+			lib.LogError(lib.LPanic, "Could not delete code", d.Error)
 		}
 
 		return nil
 	})
 	if err != nil {
-		lib.LogError(lib.LPanic, "Could not add to Claimers", err)
+		return false, err
 	}
 
-	return &model.Wish{
-		ID:                  wish.ID,
-		Owner:               wish.Owner,
-		Name:                wish.Name,
-		Description:         wish.Description,
-		Link:                wish.Link,
-		Image:               wish.Image,
-		FulfillmentClaimers: wish.ID,
-		Fulfillers:          wish.ID,
-	}, nil
+	return true, nil
 }
 
-func (r *mutationResolver) AcceptFulfillmentClaim(ctx context.Context, input model.FulfillmentClaimer) (*model.Wish, error) {
-	err := lib.Validator.Struct(&input)
+func (r *mutationResolver) SetUserRole(ctx context.Context, id string, role model.Role, reason *string) (bool, error) {
+	newRole := dbmodel.Role(strings.ToLower(role.String()))
+
+	err := lib.Validator.Var(reason, "omitempty,max=1000")
 	if err != nil {
-		return nil, lib.ErrValidationFailed
+		return false, lib.ErrValidationFailed
 	}
 
-	return r.handleClaimer(ctx, input.WishID, input.ClaimerID, dbmodel.WishFulFillersAsso)
+	// The role is recorded along with the reason so that the audit log
+	// shows what the user was given
+	audit := "Role set to " + role.String()
+	if reason != nil {
+		audit += ": " + *reason
+	}
+
+	err = r.moderateUser(ctx, model.UserModeration{ID: id, Reason: &audit}, dbmodel.ActionSetRole, func(tx *gorm.DB, user *dbmodel.User) error {
+		d := tx.Model(user).Update("role", newRole)
+		if d.Error != nil {
+			lib.LogError(lib.LPanic, "Could not update user's role", d.Error)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
 }
 
-func (r *mutationResolver) RejectFulfillmentClaim(ctx context.Context, input model.FulfillmentClaimer) (*model.Wish, error) {
+func (r *mutationResolver) AdminDeleteWish(ctx context.Context, input model.WishModeration) (int, error) {
+	var wish dbmodel.Wish
+
+	authedUser := dbmodel.AuthedUserFromCtx(ctx)
+
 	err := lib.Validator.Struct(&input)
+	if err != nil {
+		return 0, lib.ErrValidationFailed
+	}
+
+	d := r.DB.Select("id, owner").First(&wish, input.ID)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read wish", d.Error)
+	} else if d.RecordNotFound() {
+		return 0, dbmodel.ErrWishNotFound
+	}
+
+	err = r.DB.Transaction(func(tx *gorm.DB) error {
+		d := tx.Delete(wish)
+		if d.Error != nil {
+			return d.Error
+		}
+
+		d = tx.Model(&dbmodel.Report{}).Where("wish_id = ? AND resolved_at IS NULL", wish.ID).Updates(map[string]interface{}{
+			"resolved_by": authedUser,
+			"resolved_at": time.Now().UTC(),
+		})
+		if d.Error != nil {
+			return d.Error
+		}
+
+		return dbmodel.RecordAdminAction(tx, authedUser, dbmodel.ActionDeleteWish,
+			dbmodel.TargetWish, strconv.Itoa(wish.ID), input.Reason)
+	})
+	if err != nil {
+		lib.LogError(lib.LPanic, "Could not delete wish", err)
+	}
+
+	return wish.ID, nil
+}
+
+func (r *mutationResolver) ResolveReport(ctx context.Context, id int) (*model.Report, error) {
+	var report dbmodel.Report
+
+	authedUser := dbmodel.AuthedUserFromCtx(ctx)
+
+	err := lib.Validator.Var(id, "min=0")
 	if err != nil {
 		return nil, lib.ErrValidationFailed
 	}
 
-	return r.handleClaimer(ctx, input.WishID, input.ClaimerID, dbmodel.WishWantToFulfillAsso)
+	d := r.DB.First(&report, id)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read report", d.Error)
+	} else if d.RecordNotFound() {
+		return nil, dbmodel.ErrReportNotFound
+	}
+
+	if report.ResolvedAt != nil {
+		return nil, dbmodel.ErrReportResolved
+	}
+
+	now := time.Now().UTC()
+	report.ResolvedBy = &authedUser
+	report.ResolvedAt = &now
+
+	err = r.DB.Transaction(func(tx *gorm.DB) error {
+		d := tx.Model(&report).Updates(map[string]interface{}{
+			"resolved_by": authedUser,
+			"resolved_at": now,
+		})
+		if d.Error != nil {
+			return d.Error
+		}
+
+		return dbmodel.RecordAdminAction(tx, authedUser, dbmodel.ActionResolveReport,
+			dbmodel.TargetReport, strconv.Itoa(report.ID), nil)
+	})
+	if err != nil {
+		lib.LogError(lib.LPanic, "Could not resolve report", err)
+	}
+
+	return r.report(&report), nil
 }
 
 func (r *queryResolver) User(ctx context.Context, id string) (*model.User, error) {
@@ -572,6 +1870,206 @@ func (r *queryResolver) Wish(ctx context.Context, id int) (*model.Wish, error) {
 	return r.wish(ctx, id)
 }
 
+func (r *queryResolver) Wishlist(ctx context.Context, id int) (*model.Wishlist, error) {
+	return r.wishlist(ctx, id)
+}
+
+func (r *queryResolver) MyOccasions(ctx context.Context, page int, limit int) ([]*model.Occasion, error) {
+	var occasions []dbmodel.Occasion
+	var res []*model.Occasion
+
+	authedUser := dbmodel.AuthedUserFromCtx(ctx)
+
+	err := lib.Validator.Struct(struct {
+		Page  int `validate:"min=1"`
+		Limit int `validate:"min=1,max=10"`
+	}{Page: page, Limit: limit})
+	if err != nil {
+		return nil, lib.ErrValidationFailed
+	}
+
+	d := r.DB.Select(dbmodel.OccasionColumns).Where("owner = ?", authedUser).Order("date, id").Offset(
+		(page * limit) - limit).Limit(limit).Find(&occasions)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read user's occasions", d.Error)
+	}
+
+	for _, o := range occasions {
+		res = append(res, r.occasionModel(&o))
+	}
+
+	return res, nil
+}
+
+func (r *queryResolver) MySessions(ctx context.Context) ([]*model.Session, error) {
+	var sessions []dbmodel.Session
+	var res []*model.Session
+
+	authedUser := dbmodel.AuthedUserFromCtx(ctx)
+	authedSession := dbmodel.AuthedSessionFromCtx(ctx)
+
+	d := r.DB.Where("user_id = ?", authedUser).Order("last_used_at desc").Find(&sessions)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read user's sessions", d.Error)
+	}
+
+	for _, s := range sessions {
+		res = append(res, &model.Session{
+			ID:         s.ID,
+			UserAgent:  s.UserAgent,
+			IP:         s.IP,
+			CreatedAt:  *s.CreatedAt,
+			LastUsedAt: *s.LastUsedAt,
+			Current:    s.ID == authedSession,
+		})
+	}
+
+	return res, nil
+}
+
+func (r *queryResolver) MyIdentities(ctx context.Context) ([]*model.Identity, error) {
+	var identities []dbmodel.Identity
+	var res []*model.Identity
+
+	authedUser := dbmodel.AuthedUserFromCtx(ctx)
+
+	d := r.DB.Where("user_id = ?", authedUser).Order("created_at").Find(&identities)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read user's identities", d.Error)
+	}
+
+	for _, i := range identities {
+		res = append(res, &model.Identity{
+			Provider:  i.Provider,
+			Email:     i.Email,
+			CreatedAt: *i.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
+
+func (r *queryResolver) OidcProviders(ctx context.Context) ([]string, error) {
+	return oidc.Names(), nil
+}
+
+func (r *queryResolver) APITokens(ctx context.Context) ([]*model.ApiToken, error) {
+	var tokens []dbmodel.APIToken
+	var res []*model.ApiToken
+
+	authedUser := dbmodel.AuthedUserFromCtx(ctx)
+
+	d := r.DB.Where("user_id = ?", authedUser).Order("created_at").Find(&tokens)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read user's API tokens", d.Error)
+	}
+
+	for i := range tokens {
+		res = append(res, r.apiToken(&tokens[i]))
+	}
+
+	return res, nil
+}
+
+func (r *queryResolver) ExportMyData(ctx context.Context, format model.ExportFormat) (*model.DataExport, error) {
+	var data []byte
+	var filename, contentType string
+
+	authedUser := dbmodel.AuthedUserFromCtx(ctx)
+
+	export, err := dbmodel.ExportUserData(authedUser)
+	if err != nil {
+		return nil, err
+	}
+
+	filename = "wishlist-" + authedUser + "-" + export.ExportedAt.Format("20060102")
+	switch format {
+	case model.ExportFormatZip:
+		data = export.ZIP()
+		filename += ".zip"
+		contentType = "application/zip"
+	default:
+		data = export.JSON()
+		filename += ".json"
+		contentType = "application/json"
+	}
+
+	return &model.DataExport{
+		Filename:    filename,
+		ContentType: contentType,
+		Data:        base64.StdEncoding.EncodeToString(data),
+	}, nil
+}
+
+func (r *queryResolver) Reports(ctx context.Context, resolved bool, page int, limit int) ([]*model.Report, error) {
+	var reports []dbmodel.Report
+	var res []*model.Report
+
+	err := lib.Validator.Struct(struct {
+		Page  int `validate:"min=1"`
+		Limit int `validate:"min=1,max=10"`
+	}{
+		Page:  page,
+		Limit: limit,
+	})
+	if err != nil {
+		return nil, lib.ErrValidationFailed
+	}
+
+	d := r.DB.Order("created_at")
+	if resolved {
+		d = d.Where("resolved_at IS NOT NULL")
+	} else {
+		d = d.Where("resolved_at IS NULL")
+	}
+
+	d = d.Offset((page * limit) - limit).Limit(limit).Find(&reports)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read reports", d.Error)
+	}
+
+	for i := range reports {
+		res = append(res, r.report(&reports[i]))
+	}
+
+	return res, nil
+}
+
+func (r *queryResolver) AdminActions(ctx context.Context, page int, limit int) ([]*model.AdminAction, error) {
+	var actions []dbmodel.AdminAction
+	var res []*model.AdminAction
+
+	err := lib.Validator.Struct(struct {
+		Page  int `validate:"min=1"`
+		Limit int `validate:"min=1,max=10"`
+	}{
+		Page:  page,
+		Limit: limit,
+	})
+	if err != nil {
+		return nil, lib.ErrValidationFailed
+	}
+
+	d := r.DB.Order("created_at DESC").Offset((page * limit) - limit).Limit(limit).Find(&actions)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read admin actions", d.Error)
+	}
+
+	for _, a := range actions {
+		res = append(res, &model.AdminAction{
+			ID:         a.ID,
+			Admin:      a.AdminID,
+			Action:     string(a.Action),
+			TargetType: string(a.TargetType),
+			TargetID:   a.TargetID,
+			Reason:     a.Reason,
+			CreatedAt:  *a.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
+
 // Mutation returns generated.MutationResolver implementation.
 func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
 
@@ -19,6 +19,12 @@ func (r *userResolver) Wishes(ctx context.Context, obj *model.User) (*model.Wish
 	}, nil
 }
 
+func (r *userResolver) Wishlists(ctx context.Context, obj *model.User) (*model.Wishlists, error) {
+	return &model.Wishlists{
+		InObj: obj,
+	}, nil
+}
+
 func (r *userResolver) Friends(ctx context.Context, obj *model.User) (*model.Users, error) {
 	return &model.Users{
 		InObj:         obj,
@@ -33,13 +39,24 @@ func (r *userResolver) FriendRequests(ctx context.Context, obj *model.User) (*mo
 	}, nil
 }
 
+func (r *userResolver) OccasionReminders(ctx context.Context, obj *model.User) (bool, error) {
+	var user dbmodel.User
+
+	d := r.DB.Select("occasion_reminders").Where("id = ?", obj.ID).First(&user)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read user", d.Error)
+	} else if d.RecordNotFound() {
+		return false, dbmodel.ErrUserNotFound
+	}
+
+	return user.OccasionReminders, nil
+}
+
 func (r *usersResolver) Query(ctx context.Context, obj *model.Users, page int, limit int) ([]*model.User, error) {
 	var users []dbmodel.User
 	var res []*model.User
 	var d *gorm.DB
 
-	authedUser := dbmodel.AuthedUserFromCtx(ctx)
-
 	err := lib.Validator.Struct(struct {
 		Page  int `validate:"min=1"`
 		Limit int `validate:"min=1,max=10"`
@@ -51,24 +68,20 @@ func (r *usersResolver) Query(ctx context.Context, obj *model.Users, page int, l
 		return nil, lib.ErrValidationFailed
 	}
 
+	if obj.Hidden {
+		return res, nil
+	}
+
 	switch o := obj.InObj.(type) {
 	case *model.User:
-		if authedUser != o.ID {
-			return nil, dbmodel.ErrUserNotAuthorized
-		}
-
-		d = r.DB.Model(&dbmodel.User{ID: authedUser})
+		d = r.DB.Model(&dbmodel.User{ID: o.ID})
 	case *model.Wish:
-		if authedUser != o.Owner {
-			return nil, dbmodel.ErrUserNotAuthorized
-		}
-
 		d = r.DB.Model(&dbmodel.Wish{ID: o.ID})
 	default:
 		lib.LogError(lib.LPanic, "Model object type assertion failed", nil)
 	}
 
-	d.Select("id, first_name, last_name").Offset(
+	d.Scopes(dbmodel.ActiveUsers).Select("id, first_name, last_name").Offset(
 		(page * limit) - limit).Limit(limit).Association(string(obj.InAssociation)).Find(&users)
 	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
 		lib.LogError(lib.LPanic, "Could not read users", d.Error)
@@ -90,6 +103,10 @@ func (r *usersResolver) Query(ctx context.Context, obj *model.Users, page int, l
 func (r *usersResolver) Count(ctx context.Context, obj *model.Users) (int, error) {
 	var d *gorm.DB
 
+	if obj.Hidden {
+		return 0, nil
+	}
+
 	switch o := obj.InObj.(type) {
 	case *model.User:
 		d = r.DB.Model(&dbmodel.User{ID: o.ID})
@@ -100,7 +117,7 @@ func (r *usersResolver) Count(ctx context.Context, obj *model.Users) (int, error
 		lib.LogError(lib.LPanic, "Model object type assertion failed", nil)
 	}
 
-	return d.Association(string(obj.InAssociation)).Count(), nil
+	return d.Scopes(dbmodel.ActiveUsers).Association(string(obj.InAssociation)).Count(), nil
 }
 
 // User returns generated.UserResolver implementation.
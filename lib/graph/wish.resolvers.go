@@ -11,24 +11,45 @@ import (
 	dbmodel "github.com/ryakosh/wishlist/lib/db/model"
 	"github.com/ryakosh/wishlist/lib/graph/generated"
 	"github.com/ryakosh/wishlist/lib/graph/model"
+	"github.com/ryakosh/wishlist/lib/policy"
 )
 
 func (r *wishResolver) Owner(ctx context.Context, obj *model.Wish) (*model.User, error) {
 	return r.user(ctx, obj.Owner)
 }
 
-func (r *wishResolver) FulfillmentClaimers(ctx context.Context, obj *model.Wish) (*model.Users, error) {
+func (r *wishResolver) Wishlist(ctx context.Context, obj *model.Wish) (*model.Wishlist, error) {
+	if obj.WishlistID == nil {
+		return nil, nil
+	}
+
+	return r.wishlist(ctx, *obj.WishlistID)
+}
+
+func (r *wishResolver) RemainingQuantity(ctx context.Context, obj *model.Wish) (int, error) {
+	return dbmodel.RemainingQuantity(r.DB, &dbmodel.Wish{ID: obj.ID, Quantity: obj.Quantity}), nil
+}
+
+func (r *wishResolver) Reserved(ctx context.Context, obj *model.Wish) (bool, error) {
+	return dbmodel.IsReserved(obj.ID), nil
+}
+
+func (r *wishResolver) Audience(ctx context.Context, obj *model.Wish) (*model.Users, error) {
+	// Audience is only known to the owner, even its size is hidden
+	// from the others
 	return &model.Users{
 		InObj:         obj,
-		InAssociation: dbmodel.WishClaimersAsso,
+		InAssociation: dbmodel.WishAudienceAsso,
+		Hidden:        !r.Enforcer.Allowed(r.subject(ctx), obj, policy.Owner, policy.Admin),
 	}, nil
 }
 
+func (r *wishResolver) FulfillmentClaimers(ctx context.Context, obj *model.Wish) (*model.Users, error) {
+	return r.claims(ctx, obj, dbmodel.WishClaimersAsso), nil
+}
+
 func (r *wishResolver) Fulfillers(ctx context.Context, obj *model.Wish) (*model.Users, error) {
-	return &model.Users{
-		InObj:         obj,
-		InAssociation: dbmodel.WishFulFillersAsso,
-	}, nil
+	return r.claims(ctx, obj, dbmodel.WishFulFillersAsso), nil
 }
 
 func (r *wishesResolver) Query(ctx context.Context, obj *model.Wishes, page int, limit int) ([]*model.Wish, error) {
@@ -43,31 +64,29 @@ func (r *wishesResolver) Query(ctx context.Context, obj *model.Wishes, page int,
 		return nil, lib.ErrValidationFailed
 	}
 
-	d := r.DB.Model(&dbmodel.User{ID: obj.InObj.ID}).Select(
-		"id, name, owner, description, link, image").Offset(
-		(page * limit) - limit).Limit(limit).Association(string(dbmodel.UserWishesAsso)).Find(&wishes)
+	viewer := dbmodel.AuthedUserFromCtx(ctx)
+
+	parent, asso := r.wishesOf(obj)
+
+	d := parent.Scopes(dbmodel.VisibleWishes(viewer)).Select(dbmodel.WishColumns).Offset(
+		(page * limit) - limit).Limit(limit).Association(string(asso)).Find(&wishes)
 	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
-		lib.LogError(lib.LPanic, "Could not read user's friends", d.Error)
+		lib.LogError(lib.LPanic, "Could not read wishes", d.Error)
 	}
 
 	for _, w := range wishes {
-		res = append(res, &model.Wish{
-			ID:                  w.ID,
-			Owner:               w.Owner,
-			Name:                w.Name,
-			Description:         w.Description,
-			Link:                w.Link,
-			Image:               w.Image,
-			FulfillmentClaimers: w.ID,
-			Fulfillers:          w.ID,
-		})
+		res = append(res, r.wishModel(&w))
 	}
 
 	return res, nil
 }
 
 func (r *wishesResolver) Count(ctx context.Context, obj *model.Wishes) (int, error) {
-	return r.DB.Model(&dbmodel.User{ID: obj.InObj.ID}).Association(string(dbmodel.UserWishesAsso)).Count(), nil
+	viewer := dbmodel.AuthedUserFromCtx(ctx)
+
+	parent, asso := r.wishesOf(obj)
+
+	return parent.Scopes(dbmodel.VisibleWishes(viewer)).Association(string(asso)).Count(), nil
 }
 
 // Wish returns generated.WishResolver implementation.
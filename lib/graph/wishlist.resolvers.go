@@ -0,0 +1,73 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/ryakosh/wishlist/lib"
+	dbmodel "github.com/ryakosh/wishlist/lib/db/model"
+	"github.com/ryakosh/wishlist/lib/graph/generated"
+	"github.com/ryakosh/wishlist/lib/graph/model"
+)
+
+func (r *wishlistResolver) Owner(ctx context.Context, obj *model.Wishlist) (*model.User, error) {
+	return r.user(ctx, obj.Owner)
+}
+
+func (r *wishlistResolver) EventDate(ctx context.Context, obj *model.Wishlist) (*time.Time, error) {
+	return dbmodel.NextWishlistDate(obj.ID, time.Now()), nil
+}
+
+func (r *wishlistResolver) Wishes(ctx context.Context, obj *model.Wishlist) (*model.Wishes, error) {
+	return &model.Wishes{
+		InObj: obj,
+	}, nil
+}
+
+func (r *wishlistsResolver) Query(ctx context.Context, obj *model.Wishlists, page int, limit int) ([]*model.Wishlist, error) {
+	var wishlists []dbmodel.Wishlist
+	var res []*model.Wishlist
+
+	err := lib.Validator.Struct(struct {
+		Page  int `validate:"min=1"`
+		Limit int `validate:"min=1,max=10"`
+	}{Page: page, Limit: limit})
+	if err != nil {
+		return nil, lib.ErrValidationFailed
+	}
+
+	viewer := dbmodel.AuthedUserFromCtx(ctx)
+
+	d := r.DB.Model(&dbmodel.User{ID: obj.InObj.ID}).Scopes(dbmodel.VisibleWishlists(viewer), dbmodel.ActiveOwners).Select(
+		dbmodel.WishlistColumns).Order("position, id").Offset(
+		(page * limit) - limit).Limit(limit).Association(string(dbmodel.UserWishlistsAsso)).Find(&wishlists)
+	if d.Error != nil && !gorm.IsRecordNotFoundError(d.Error) {
+		lib.LogError(lib.LPanic, "Could not read user's wishlists", d.Error)
+	}
+
+	for _, w := range wishlists {
+		res = append(res, r.wishlistModel(&w))
+	}
+
+	return res, nil
+}
+
+func (r *wishlistsResolver) Count(ctx context.Context, obj *model.Wishlists) (int, error) {
+	viewer := dbmodel.AuthedUserFromCtx(ctx)
+
+	return r.DB.Model(&dbmodel.User{ID: obj.InObj.ID}).Scopes(dbmodel.VisibleWishlists(viewer), dbmodel.ActiveOwners).Association(
+		string(dbmodel.UserWishlistsAsso)).Count(), nil
+}
+
+// Wishlist returns generated.WishlistResolver implementation.
+func (r *Resolver) Wishlist() generated.WishlistResolver { return &wishlistResolver{r} }
+
+// Wishlists returns generated.WishlistsResolver implementation.
+func (r *Resolver) Wishlists() generated.WishlistsResolver { return &wishlistsResolver{r} }
+
+type wishlistResolver struct{ *Resolver }
+type wishlistsResolver struct{ *Resolver }
@@ -0,0 +1,88 @@
+package oidc
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/ryakosh/wishlist/lib"
+)
+
+// ErrProviderNotFound is returned when the requested provider is not configured
+var ErrProviderNotFound = errors.New("Identity provider not found")
+
+var (
+	// providersEnv is an environment variable used to set the path of a JSON
+	// file containing an array of providers, social login is disabled when
+	// it's not set
+	providersEnv string
+
+	mu        sync.RWMutex
+	providers = map[string]*Provider{}
+)
+
+// Register is used to make a provider available for logging in, it replaces
+// any provider that is registered with the same name
+func Register(p *Provider) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	providers[p.Name] = p
+}
+
+// Get returns the provider that is registered with name
+func Get(name string) (*Provider, error) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	p, ok := providers[name]
+	if !ok {
+		return nil, ErrProviderNotFound
+	}
+
+	return p, nil
+}
+
+// Names returns the names of all registered providers in order
+func Names() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	names := []string{}
+	for name := range providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func init() {
+	var ps []*Provider
+
+	providersEnv = os.Getenv("WISHLIST_OIDC_PROVIDERS")
+	if len(providersEnv) == 0 {
+		return
+	}
+
+	f, err := ioutil.ReadFile(providersEnv)
+	if err != nil {
+		lib.LogError(lib.LFatal, "Could not read identity providers file", err)
+	}
+
+	err = json.Unmarshal(f, &ps)
+	if err != nil {
+		lib.LogError(lib.LFatal, "Could not parse identity providers file", err)
+	}
+
+	for _, p := range ps {
+		if p.Name == "" || p.Issuer == "" || p.ClientID == "" || p.RedirectURL == "" {
+			lib.LogError(lib.LFatal, "Identity providers must have name, issuer, clientId and redirectUrl", nil)
+		}
+
+		Register(p)
+	}
+}
@@ -0,0 +1,282 @@
+package oidc
+
+import (
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/ryakosh/wishlist/lib"
+)
+
+var (
+	// ErrProviderUnavailable is returned when the provider could not be
+	// reached or responded with an unexpected response
+	ErrProviderUnavailable = errors.New("Identity provider is unavailable")
+
+	// ErrIDTokenInvalid is returned when the ID token issued by the
+	// provider could not be verified
+	ErrIDTokenInvalid = errors.New("ID token is invalid")
+)
+
+var httpClient = &http.Client{Timeout: time.Second * 10}
+
+// Provider is an OpenID Connect identity provider that users can log in
+// with, endpoints are discovered from the issuer so any compliant provider,
+// including a local mock one, can be used
+type Provider struct {
+	Name         string   `json:"name"`
+	Issuer       string   `json:"issuer"`
+	ClientID     string   `json:"clientId"`
+	ClientSecret string   `json:"clientSecret"`
+	RedirectURL  string   `json:"redirectUrl"`
+	Scopes       []string `json:"scopes"`
+
+	mu        sync.Mutex
+	discovery *discovery
+	keys      map[string]*rsa.PublicKey
+}
+
+type discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// IDToken holds the verified claims of an ID token
+type IDToken struct {
+	Subject           string
+	Email             string
+	EmailVerified     bool
+	PreferredUsername string
+}
+
+// GenPKCE is used to generate a PKCE code verifier and it's S256 challenge
+func GenPKCE() (string, string, error) {
+	rands, _, err := lib.GenSafeRandomBytes(32)
+	if err != nil {
+		return "", "", err
+	}
+
+	verifier := base64.RawURLEncoding.EncodeToString(rands)
+	sum := sha256.Sum256([]byte(verifier))
+
+	return verifier, base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
+
+func getJSON(endpoint string, v interface{}) error {
+	res, err := httpClient.Get(endpoint)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d from %s", res.StatusCode, endpoint)
+	}
+
+	return json.NewDecoder(res.Body).Decode(v)
+}
+
+func (p *Provider) getDiscovery() (*discovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+
+	var d discovery
+	err := getJSON(strings.TrimSuffix(p.Issuer, "/")+"/.well-known/openid-configuration", &d)
+	if err != nil {
+		lib.LogError(lib.LError, "Could not discover identity provider '"+p.Name+"'", err)
+		return nil, ErrProviderUnavailable
+	}
+
+	p.discovery = &d
+
+	return p.discovery, nil
+}
+
+// AuthURL returns the URL that users should be redirected to for
+// authorizing wishlist using the authorization code flow with PKCE
+func (p *Provider) AuthURL(state, nonce, challenge string) (string, error) {
+	d, err := p.getDiscovery()
+	if err != nil {
+		return "", err
+	}
+
+	scopes := p.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{"openid", "email", "profile"}
+	}
+
+	params := url.Values{}
+	params.Set("response_type", "code")
+	params.Set("client_id", p.ClientID)
+	params.Set("redirect_uri", p.RedirectURL)
+	params.Set("scope", strings.Join(scopes, " "))
+	params.Set("state", state)
+	params.Set("nonce", nonce)
+	params.Set("code_challenge", challenge)
+	params.Set("code_challenge_method", "S256")
+
+	return d.AuthorizationEndpoint + "?" + params.Encode(), nil
+}
+
+// Exchange is used to exchange the authorization code for an ID token
+// and verify it against the nonce that was sent in the authorization request
+func (p *Provider) Exchange(code, verifier, nonce string) (*IDToken, error) {
+	var tokens struct {
+		IDToken string `json:"id_token"`
+	}
+
+	d, err := p.getDiscovery()
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.RedirectURL)
+	form.Set("client_id", p.ClientID)
+	form.Set("code_verifier", verifier)
+	if p.ClientSecret != "" {
+		form.Set("client_secret", p.ClientSecret)
+	}
+
+	res, err := httpClient.PostForm(d.TokenEndpoint, form)
+	if err != nil {
+		lib.LogError(lib.LError, "Could not exchange authorization code", err)
+		return nil, ErrProviderUnavailable
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, ErrIDTokenInvalid
+	}
+
+	err = json.NewDecoder(res.Body).Decode(&tokens)
+	if err != nil || tokens.IDToken == "" {
+		return nil, ErrIDTokenInvalid
+	}
+
+	return p.verify(d, tokens.IDToken, nonce)
+}
+
+func (p *Provider) verify(d *discovery, idToken, nonce string) (*IDToken, error) {
+	claims := jwt.MapClaims{}
+
+	token, err := jwt.ParseWithClaims(idToken, claims, func(t *jwt.Token) (interface{}, error) {
+		if t.Method != jwt.SigningMethodRS256 {
+			return nil, ErrIDTokenInvalid
+		}
+
+		kid, _ := t.Header["kid"].(string)
+
+		return p.key(d, kid)
+	})
+	if err != nil || !token.Valid {
+		return nil, ErrIDTokenInvalid
+	}
+
+	if !claims.VerifyIssuer(d.Issuer, true) || !hasAudience(claims["aud"], p.ClientID) {
+		return nil, ErrIDTokenInvalid
+	}
+
+	if n, _ := claims["nonce"].(string); n != nonce {
+		return nil, ErrIDTokenInvalid
+	}
+
+	sub, _ := claims["sub"].(string)
+	if sub == "" {
+		return nil, ErrIDTokenInvalid
+	}
+
+	email, _ := claims["email"].(string)
+	emailVerified, _ := claims["email_verified"].(bool)
+	preferredUsername, _ := claims["preferred_username"].(string)
+
+	return &IDToken{
+		Subject:           sub,
+		Email:             strings.ToLower(email),
+		EmailVerified:     emailVerified,
+		PreferredUsername: preferredUsername,
+	}, nil
+}
+
+func hasAudience(aud interface{}, clientID string) bool {
+	switch a := aud.(type) {
+	case string:
+		return a == clientID
+	case []interface{}:
+		for _, v := range a {
+			if s, ok := v.(string); ok && s == clientID {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// key returns provider's public key with kid, keys are refetched when
+// kid is unknown to support key rotation
+func (p *Provider) key(d *discovery, kid string) (*rsa.PublicKey, error) {
+	var set struct {
+		Keys []lib.JWK `json:"keys"`
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if key, ok := p.keys[kid]; ok {
+		return key, nil
+	}
+
+	err := getJSON(d.JWKSURI, &set)
+	if err != nil {
+		lib.LogError(lib.LError, "Could not fetch identity provider's keys", err)
+		return nil, ErrProviderUnavailable
+	}
+
+	p.keys = map[string]*rsa.PublicKey{}
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" {
+			continue
+		}
+
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			continue
+		}
+
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			continue
+		}
+
+		p.keys[k.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+
+	key, ok := p.keys[kid]
+	if !ok {
+		return nil, ErrIDTokenInvalid
+	}
+
+	return key, nil
+}
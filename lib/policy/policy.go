@@ -0,0 +1,89 @@
+package policy
+
+// Rule is a condition that grants a subject access to a resource
+type Rule string
+
+const (
+	// Owner grants access to the user that owns the resource
+	Owner Rule = "OWNER"
+
+	// Friend grants access to friends of the user that owns the resource,
+	// or friends of the user if the resource is a user
+	Friend Rule = "FRIEND"
+
+	// Self grants access to a user resource only to the user itself
+	Self Rule = "SELF"
+
+	// Admin grants access to administrators
+	Admin Rule = "ADMIN"
+)
+
+// Subject is the authenticated user that is trying to access a resource
+type Subject struct {
+	ID    string
+	Admin bool
+}
+
+// Owned is implemented by resources that belong to a user
+type Owned interface {
+	OwnedBy() string
+}
+
+// User is implemented by resources that represent a user
+type User interface {
+	UserID() string
+}
+
+// FriendChecker reports whether the two users are friends
+type FriendChecker func(user, other string) bool
+
+// Enforcer is used to evaluate rules, it does not access the database
+// itself so friendships are checked using AreFriends
+type Enforcer struct {
+	AreFriends FriendChecker
+}
+
+// owner returns the user that resource belongs to, or the user itself if
+// resource is a user
+func owner(resource interface{}) (string, bool) {
+	switch r := resource.(type) {
+	case Owned:
+		return r.OwnedBy(), true
+	case User:
+		return r.UserID(), true
+	}
+
+	return "", false
+}
+
+// Allowed reports whether any of the rules grants subject access to resource,
+// anonymous subjects are never allowed
+func (e *Enforcer) Allowed(subject Subject, resource interface{}, rules ...Rule) bool {
+	if subject.ID == "" {
+		return false
+	}
+
+	for _, rule := range rules {
+		switch rule {
+		case Owner:
+			if r, ok := resource.(Owned); ok && r.OwnedBy() == subject.ID {
+				return true
+			}
+		case Self:
+			if r, ok := resource.(User); ok && r.UserID() == subject.ID {
+				return true
+			}
+		case Friend:
+			o, ok := owner(resource)
+			if ok && o != subject.ID && e.AreFriends(o, subject.ID) {
+				return true
+			}
+		case Admin:
+			if subject.Admin {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,73 @@
+package policy
+
+import "testing"
+
+type wish struct {
+	owner string
+}
+
+func (w wish) OwnedBy() string { return w.owner }
+
+type user struct {
+	id string
+}
+
+func (u user) UserID() string { return u.id }
+
+// friends is a friend checker where alice and bob are friends
+func friends(user, other string) bool {
+	pair := user + "," + other
+
+	return pair == "alice,bob" || pair == "bob,alice"
+}
+
+func TestEnforcerAllowed(t *testing.T) {
+	alice := Subject{ID: "alice"}
+	bob := Subject{ID: "bob"}
+	carol := Subject{ID: "carol"}
+	admin := Subject{ID: "admin", Admin: true}
+	anonymous := Subject{}
+
+	tests := []struct {
+		name     string
+		subject  Subject
+		resource interface{}
+		rules    []Rule
+		want     bool
+	}{
+		{"owner of wish", alice, wish{"alice"}, []Rule{Owner}, true},
+		{"non owner of wish", bob, wish{"alice"}, []Rule{Owner}, false},
+		{"owner rule on user", alice, user{"alice"}, []Rule{Owner}, false},
+		{"friend of wish owner", bob, wish{"alice"}, []Rule{Friend}, true},
+		{"stranger to wish owner", carol, wish{"alice"}, []Rule{Friend}, false},
+		{"owner is not own friend", alice, wish{"alice"}, []Rule{Friend}, false},
+		{"friend of user", bob, user{"alice"}, []Rule{Friend}, true},
+		{"self", alice, user{"alice"}, []Rule{Self}, true},
+		{"other user", bob, user{"alice"}, []Rule{Self}, false},
+		{"self rule on wish", alice, wish{"alice"}, []Rule{Self}, false},
+		{"admin", admin, wish{"alice"}, []Rule{Admin}, true},
+		{"admin without admin rule", admin, wish{"alice"}, []Rule{Owner, Friend}, false},
+		{"non admin", alice, wish{"bob"}, []Rule{Admin}, false},
+		{"anonymous", anonymous, wish{""}, []Rule{Owner, Friend, Admin}, false},
+		{"anonymous self", anonymous, user{""}, []Rule{Self}, false},
+		{"no rules", alice, wish{"alice"}, nil, false},
+		{"unknown resource", alice, struct{}{}, []Rule{Owner, Self, Friend}, false},
+
+		// Connections such as claimers of surprise wishes extend the rules
+		// of the field with their Allow rules
+		{"owner only connection", bob, wish{"alice"}, []Rule{Owner}, false},
+		{"connection allowing friends", bob, wish{"alice"}, []Rule{Owner, Friend}, true},
+		{"connection allowing friends to stranger", carol, wish{"alice"}, []Rule{Owner, Friend}, false},
+		{"connection allowing friends to owner", alice, wish{"alice"}, []Rule{Owner, Friend}, true},
+	}
+
+	e := &Enforcer{AreFriends: friends}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := e.Allowed(tt.subject, tt.resource, tt.rules...); got != tt.want {
+				t.Fatalf("Allowed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
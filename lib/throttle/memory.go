@@ -0,0 +1,42 @@
+package throttle
+
+import "sync"
+
+// MemoryStore is a Store that keeps attempts in memory, it's not shared
+// between processes and is mostly useful for tests
+type MemoryStore struct {
+	mu       sync.Mutex
+	attempts map[string]Attempts
+}
+
+// NewMemoryStore is used to create an empty MemoryStore
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		attempts: map[string]Attempts{},
+	}
+}
+
+// Update is used to atomically replace the attempts made for key
+func (s *MemoryStore) Update(key string, update func(attempts *Attempts) (*Attempts, error)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	attempts := s.attempts[key]
+
+	updated, err := update(&attempts)
+	if err != nil {
+		return err
+	}
+
+	s.attempts[key] = *updated
+
+	return nil
+}
+
+// Delete is used to forget the attempts made for key
+func (s *MemoryStore) Delete(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.attempts, key)
+}
@@ -0,0 +1,138 @@
+package throttle
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrTooManyAttempts is returned when too many attempts has failed
+// and the caller should wait before trying again
+var ErrTooManyAttempts = errors.New("Too many attempts, try again later")
+
+// Attempts holds the failed attempts that are made for a key
+type Attempts struct {
+	Failures    int
+	LastFailure time.Time
+	LockedUntil time.Time
+}
+
+// Store is used to persist failed attempts, it's pluggable so that
+// limiters can share their state between processes
+type Store interface {
+	// Update is used to atomically replace the attempts made for key with
+	// the ones that update returns, attempts is never nil and is zero if
+	// there are none, nothing is stored if update returns an error
+	Update(key string, update func(attempts *Attempts) (*Attempts, error)) error
+	Delete(key string)
+}
+
+// Policy describes how a limiter should react to failed attempts
+type Policy struct {
+	// FreeAttempts is the number of failures that are allowed
+	// before backoff kicks in
+	FreeAttempts int
+
+	// BaseDelay is the delay after the first throttled failure, it's doubled
+	// after each failure until it reaches MaxDelay
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+
+	// LockoutAfter is the number of failures that causes the key to
+	// be locked for LockoutDuration
+	LockoutAfter    int
+	LockoutDuration time.Duration
+
+	// Window is the duration after the last failure that failures are forgotten
+	Window time.Duration
+}
+
+// Limiter is used to throttle attempts using exponential backoff
+// and temporary lockouts
+type Limiter struct {
+	name   string
+	store  Store
+	policy Policy
+	now    func() time.Time
+}
+
+// New is used to create a new limiter, name is used to prefix the keys
+// so that multiple limiters can share a store
+func New(name string, store Store, policy Policy) *Limiter {
+	return &Limiter{
+		name:   name,
+		store:  store,
+		policy: policy,
+		now:    time.Now,
+	}
+}
+
+func (l *Limiter) key(key string) string {
+	return l.name + ":" + key
+}
+
+func (l *Limiter) delay(failures int) time.Duration {
+	n := failures - l.policy.FreeAttempts
+	if n < 0 {
+		return 0
+	}
+
+	delay := l.policy.BaseDelay
+	for i := 0; i < n && delay < l.policy.MaxDelay; i++ {
+		delay *= 2
+	}
+
+	if delay > l.policy.MaxDelay {
+		return l.policy.MaxDelay
+	}
+
+	return delay
+}
+
+// Reserve is used to record an attempt for key before it's made, so that
+// concurrent attempts can not bypass the limits, it returns ErrTooManyAttempts
+// if key is locked or it's still in it's backoff period. Reserved attempts
+// count as failures unless they are released using Release or Reset
+func (l *Limiter) Reserve(key string) error {
+	return l.store.Update(l.key(key), func(attempts *Attempts) (*Attempts, error) {
+		now := l.now().UTC()
+		if now.Before(attempts.LockedUntil) {
+			return nil, ErrTooManyAttempts
+		}
+
+		if attempts.Failures >= l.policy.FreeAttempts &&
+			now.Before(attempts.LastFailure.Add(l.delay(attempts.Failures))) {
+			return nil, ErrTooManyAttempts
+		}
+
+		if now.After(attempts.LastFailure.Add(l.policy.Window)) {
+			attempts = &Attempts{}
+		}
+
+		attempts.Failures++
+		attempts.LastFailure = now
+
+		if attempts.Failures >= l.policy.LockoutAfter {
+			attempts.Failures = 0
+			attempts.LockedUntil = now.Add(l.policy.LockoutDuration)
+		}
+
+		return attempts, nil
+	})
+}
+
+// Release is used to give back an attempt that was reserved for key
+// and has succeeded, other failures of key are kept
+func (l *Limiter) Release(key string) {
+	_ = l.store.Update(l.key(key), func(attempts *Attempts) (*Attempts, error) {
+		if attempts.Failures > 0 {
+			attempts.Failures--
+		}
+
+		return attempts, nil
+	})
+}
+
+// Reset is used to forget the failed attempts of key
+func (l *Limiter) Reset(key string) {
+	l.store.Delete(l.key(key))
+}
@@ -0,0 +1,170 @@
+package throttle
+
+import (
+	"testing"
+	"time"
+)
+
+var testPolicy = Policy{
+	FreeAttempts:    3,
+	BaseDelay:       time.Second,
+	MaxDelay:        time.Second * 8,
+	LockoutAfter:    10,
+	LockoutDuration: time.Hour,
+	Window:          time.Minute * 10,
+}
+
+// step is an attempt that is made after the clock has advanced by after
+type step struct {
+	after time.Duration
+	want  error
+}
+
+func TestLimiterReserve(t *testing.T) {
+	tests := []struct {
+		name  string
+		steps []step
+	}{
+		{
+			name: "free attempts",
+			steps: []step{
+				{0, nil},
+				{0, nil},
+				{0, nil},
+			},
+		},
+		{
+			name: "backoff after free attempts",
+			steps: []step{
+				{0, nil},
+				{0, nil},
+				{0, nil},
+				{0, ErrTooManyAttempts},
+				{time.Millisecond * 500, ErrTooManyAttempts},
+				{time.Millisecond * 500, nil},
+			},
+		},
+		{
+			name: "backoff doubles",
+			steps: []step{
+				{0, nil},
+				{0, nil},
+				{0, nil},
+				{time.Second, nil},
+				{time.Second, ErrTooManyAttempts},
+				{time.Second, nil},
+			},
+		},
+		{
+			name: "backoff is capped",
+			steps: []step{
+				{0, nil},
+				{0, nil},
+				{0, nil},
+				{time.Second, nil},
+				{time.Second * 2, nil},
+				{time.Second * 4, nil},
+				{time.Second * 8, nil},
+				{time.Second * 8, nil},
+			},
+		},
+		{
+			name: "lockout",
+			steps: []step{
+				{0, nil},
+				{0, nil},
+				{0, nil},
+				{time.Second, nil},
+				{time.Second * 2, nil},
+				{time.Second * 4, nil},
+				{time.Second * 8, nil},
+				{time.Second * 8, nil},
+				{time.Second * 8, nil},
+				{time.Second * 8, nil},
+				{time.Second * 8, ErrTooManyAttempts},
+				{time.Minute * 59, ErrTooManyAttempts},
+				{time.Minute, nil},
+			},
+		},
+		{
+			name: "window expiry",
+			steps: []step{
+				{0, nil},
+				{0, nil},
+				{0, nil},
+				{time.Minute * 11, nil},
+				{0, nil},
+				{0, nil},
+				{0, ErrTooManyAttempts},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+			l := New("test", NewMemoryStore(), testPolicy)
+			l.now = func() time.Time { return now }
+
+			for i, s := range tt.steps {
+				now = now.Add(s.after)
+
+				if got := l.Reserve("key"); got != s.want {
+					t.Fatalf("step %d: Reserve() = %v, want %v", i, got, s.want)
+				}
+			}
+		})
+	}
+}
+
+func TestLimiterReleaseAndReset(t *testing.T) {
+	tests := []struct {
+		name  string
+		after func(l *Limiter)
+		want  error
+	}{
+		{"release", func(l *Limiter) { l.Release("key") }, nil},
+		{"reset", func(l *Limiter) { l.Reset("key") }, nil},
+		{"neither", func(l *Limiter) {}, ErrTooManyAttempts},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+			l := New("test", NewMemoryStore(), testPolicy)
+			l.now = func() time.Time { return now }
+
+			for i := 0; i < testPolicy.FreeAttempts; i++ {
+				if err := l.Reserve("key"); err != nil {
+					t.Fatalf("Reserve() = %v, want nil", err)
+				}
+			}
+
+			tt.after(l)
+
+			if got := l.Reserve("key"); got != tt.want {
+				t.Fatalf("Reserve() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLimiterKeysAreIndependent(t *testing.T) {
+	store := NewMemoryStore()
+	user := New("user", store, testPolicy)
+	ip := New("ip", store, testPolicy)
+
+	for i := 0; i < testPolicy.FreeAttempts; i++ {
+		user.Reserve("key")
+	}
+
+	if err := user.Reserve("other"); err != nil {
+		t.Fatalf("Reserve() of another key = %v, want nil", err)
+	}
+
+	if err := ip.Reserve("key"); err != nil {
+		t.Fatalf("Reserve() of another limiter = %v, want nil", err)
+	}
+}
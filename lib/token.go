@@ -2,16 +2,56 @@ package lib
 
 import (
 	"crypto/rsa"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/dgrijalva/jwt-go"
 )
 
+const (
+	// AccessTokenTTL is used to set access token's Time-To-Live, clients should
+	// use their refresh token to obtain a new access token after this duration
+	AccessTokenTTL = time.Minute * 15
+
+	// ChallengeTokenTTL is used to set the Time-To-Live of tokens that are
+	// issued when a second authentication factor is required
+	ChallengeTokenTTL = time.Minute * 5
+)
+
+const (
+	tokenIssuer            = "Wishlist"
+	accessTokenAudience    = "wishlist"
+	challengeTokenAudience = "wishlist-2fa"
+
+	defaultKeysDir = "./secrets/keys/"
+
+	// legacyPrivateKey is the single key that was used before key rotation,
+	// it's loaded when keys directory does not exist
+	legacyPrivateKey = "./secrets/private.pem"
+	legacyKID        = "legacy"
+
+	privateKeySuffix = ".pem"
+	publicKeySuffix  = ".pub.pem"
+)
+
 var (
+	keysDirEnv string
+
+	// signingKeyEnv is used to choose the key that new tokens are signed with,
+	// defaults to the private key with the greatest id
+	signingKeyEnv string
+
+	signingKID string
 	privateKey *rsa.PrivateKey
-	publicKey  *rsa.PublicKey
+	publicKeys = map[string]*rsa.PublicKey{}
 )
 
 var (
@@ -26,20 +66,69 @@ var (
 	// ErrTokenIsInvalid is returned when the provided token for
 	// validation is invalid
 	ErrTokenIsInvalid = errors.New("Token is invalid")
+
+	// ErrTokenNotValidYet is returned when the provided token for
+	// validation is used before it's 'nbf' or 'iat' claims
+	ErrTokenNotValidYet = errors.New("Token is not valid yet")
+
+	// ErrTokenAlgorithmInvalid is returned when the provided token for
+	// validation is not signed using RS256
+	ErrTokenAlgorithmInvalid = errors.New("Token signing algorithm is invalid")
+
+	// ErrTokenIssuerInvalid is returned when the provided token for
+	// validation is not issued by wishlist
+	ErrTokenIssuerInvalid = errors.New("Token issuer is invalid")
+
+	// ErrTokenAudienceInvalid is returned when the provided token for
+	// validation is not meant to be used for the operation
+	ErrTokenAudienceInvalid = errors.New("Token audience is invalid")
+
+	// ErrUnknownKey is returned when the provided token for validation
+	// is signed by a key that is not active anymore
+	ErrUnknownKey = errors.New("Token is signed by an unknown key")
 )
 
-// Encode is used to encode JWT tokens
-func Encode(sub, email string) string {
-	now := time.Now().UTC()
-	expires := now.Add(time.Hour * 168) // Expires in one week
-
-	encodeToken := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
-		"iss":   "Wishlist",
-		"isa":   now.Unix(),
-		"exp":   expires.Unix(),
-		"sub":   sub,
-		"email": email,
-	})
+// JWK is a public key in JSON Web Key format, see RFC 7517
+type JWK struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// JWKSet is a set of JWKs, used for exposing the active public keys
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// Claims represents the claims of an access token
+type Claims struct {
+	jwt.StandardClaims
+	Email     string `json:"email"`
+	SessionID string `json:"sid"`
+}
+
+func genTokenID() string {
+	jti, _, err := GenSafeRandomBytes(16)
+	if err != nil {
+		LogError(LPanic, "Could not generate token id", err)
+	}
+
+	return hex.EncodeToString(jti)
+}
+
+// verifiableClaims is implemented by claims that embed jwt.StandardClaims
+type verifiableClaims interface {
+	jwt.Claims
+	VerifyIssuer(cmp string, req bool) bool
+	VerifyAudience(cmp string, req bool) bool
+}
+
+func encode(claims jwt.Claims) string {
+	encodeToken := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	encodeToken.Header["kid"] = signingKID
 
 	token, err := encodeToken.SignedString(privateKey)
 	if err != nil {
@@ -49,59 +138,228 @@ func Encode(sub, email string) string {
 	return token
 }
 
-// Decode is used to decode JWT tokens
-func Decode(tokenString string) (jwt.MapClaims, bool, error) {
-	token, err := jwt.Parse(tokenString, func(t *jwt.Token) (interface{}, error) {
+func standardClaims(sub, aud string, ttl time.Duration) jwt.StandardClaims {
+	now := time.Now().UTC()
+
+	return jwt.StandardClaims{
+		Id:        genTokenID(),
+		Issuer:    tokenIssuer,
+		Audience:  aud,
+		Subject:   sub,
+		IssuedAt:  now.Unix(),
+		NotBefore: now.Unix(),
+		ExpiresAt: now.Add(ttl).Unix(),
+	}
+}
+
+func decode(tokenString string, aud string, claims verifiableClaims) error {
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (interface{}, error) {
+		if t.Method != jwt.SigningMethodRS256 {
+			return nil, ErrTokenAlgorithmInvalid
+		}
+
+		kid, _ := t.Header["kid"].(string)
+
+		publicKey, ok := publicKeys[kid]
+		if !ok {
+			return nil, ErrUnknownKey
+		}
+
 		return publicKey, nil
 	})
 
 	if err != nil {
-		return nil, false, err
+		ve, ok := err.(*jwt.ValidationError)
+		if !ok {
+			return ErrTokenIsInvalid
+		}
+
+		switch {
+		case ve.Inner == ErrTokenAlgorithmInvalid || ve.Inner == ErrUnknownKey:
+			return ve.Inner
+		case ve.Errors&jwt.ValidationErrorMalformed != 0:
+			return ErrTokenIsMalformed
+		case ve.Errors&jwt.ValidationErrorExpired != 0:
+			return ErrTokenHasExpired
+		case ve.Errors&(jwt.ValidationErrorNotValidYet|jwt.ValidationErrorIssuedAt) != 0:
+			return ErrTokenNotValidYet
+		default:
+			return ErrTokenIsInvalid
+		}
 	}
 
-	if claims, ok := token.Claims.(jwt.MapClaims); ok {
-		return claims, token.Valid, nil
+	if !token.Valid {
+		return ErrTokenIsInvalid
 	}
 
-	return nil, false, errors.New("error: Token is invalid")
-}
+	if !claims.VerifyIssuer(tokenIssuer, true) {
+		return ErrTokenIssuerInvalid
+	}
 
-// IsMalformed reports whether err represents a token is malformed error
-func IsMalformed(err error) bool {
-	if ve, ok := err.(*jwt.ValidationError); ok {
-		return ve.Errors&jwt.ValidationErrorMalformed != 0
+	if !claims.VerifyAudience(aud, true) {
+		return ErrTokenAudienceInvalid
 	}
 
-	return false
+	return nil
+}
+
+// Encode is used to encode JWT access tokens that are bound to a session
+func Encode(sub, email, sid string) string {
+	return encode(&Claims{
+		StandardClaims: standardClaims(sub, accessTokenAudience, AccessTokenTTL),
+		Email:          email,
+		SessionID:      sid,
+	})
 }
 
-// HasExpired reports whether err represents a token has expired error
-func HasExpired(err error) bool {
-	if ve, ok := err.(*jwt.ValidationError); ok {
-		return ve.Errors&jwt.ValidationErrorExpired != 0
+// Decode is used to decode and validate JWT access tokens, returned errors
+// are one of the ErrToken* errors or ErrUnknownKey
+func Decode(tokenString string) (*Claims, error) {
+	claims := &Claims{}
+
+	err := decode(tokenString, accessTokenAudience, claims)
+	if err != nil {
+		return nil, err
 	}
 
-	return false
+	return claims, nil
 }
 
-func init() {
-	prv, err := ioutil.ReadFile("./secrets/private.pem")
+// EncodeChallenge is used to encode JWT tokens that prove the user has
+// passed the first authentication factor, they can not be used as
+// access tokens
+func EncodeChallenge(sub string) string {
+	claims := standardClaims(sub, challengeTokenAudience, ChallengeTokenTTL)
+
+	return encode(&claims)
+}
+
+// DecodeChallenge is used to decode and validate challenge tokens and
+// returns the user that the token was issued for
+func DecodeChallenge(tokenString string) (string, error) {
+	claims := &jwt.StandardClaims{}
+
+	err := decode(tokenString, challengeTokenAudience, claims)
 	if err != nil {
-		LogError(LFatal, "Could not read './secrets/private.pem' file", err)
+		return "", err
 	}
 
-	privateKey, err = jwt.ParseRSAPrivateKeyFromPEM(prv)
+	return claims.Subject, nil
+}
+
+// JWKS returns all the public keys that tokens are verified against
+func JWKS() *JWKSet {
+	set := &JWKSet{Keys: []JWK{}}
+
+	for kid, key := range publicKeys {
+		set.Keys = append(set.Keys, JWK{
+			Kty: "RSA",
+			Use: "sig",
+			Alg: jwt.SigningMethodRS256.Alg(),
+			Kid: kid,
+			N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		})
+	}
+
+	sort.Slice(set.Keys, func(i, j int) bool {
+		return set.Keys[i].Kid < set.Keys[j].Kid
+	})
+
+	return set
+}
+
+// loadKeys is used to load the keys in keys directory, '<kid>.pem' files
+// are private keys that can be used for signing and '<kid>.pub.pem' files are
+// public keys of retired private keys that are only used for verification
+func loadKeys(dir string) {
+	var kids []string
+
+	files, err := ioutil.ReadDir(dir)
 	if err != nil {
-		LogError(LFatal, "Could not parse './secrets/private.pem'", err)
+		LogError(LFatal, "Could not read keys directory", err)
+	}
+
+	privateKeys := map[string]*rsa.PrivateKey{}
+	for _, f := range files {
+		name := f.Name()
+		if f.IsDir() || !strings.HasSuffix(name, privateKeySuffix) {
+			continue
+		}
+
+		pem, err := ioutil.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			LogError(LFatal, "Could not read '"+name+"' file", err)
+		}
+
+		if strings.HasSuffix(name, publicKeySuffix) {
+			kid := strings.TrimSuffix(name, publicKeySuffix)
+
+			publicKeys[kid], err = jwt.ParseRSAPublicKeyFromPEM(pem)
+			if err != nil {
+				LogError(LFatal, "Could not parse '"+name+"'", err)
+			}
+		} else {
+			kid := strings.TrimSuffix(name, privateKeySuffix)
+
+			privateKeys[kid], err = jwt.ParseRSAPrivateKeyFromPEM(pem)
+			if err != nil {
+				LogError(LFatal, "Could not parse '"+name+"'", err)
+			}
+			kids = append(kids, kid)
+		}
+	}
+
+	for kid, key := range privateKeys {
+		publicKeys[kid] = &key.PublicKey
 	}
 
-	pub, err := ioutil.ReadFile("./secrets/public.pem")
+	if len(kids) == 0 {
+		LogError(LFatal, "Could not find any private key in '"+dir+"'", nil)
+	}
+
+	sort.Strings(kids)
+	signingKID = kids[len(kids)-1]
+	if signingKeyEnv != "" {
+		signingKID = signingKeyEnv
+	}
+
+	var ok bool
+	privateKey, ok = privateKeys[signingKID]
+	if !ok {
+		LogError(LFatal, "Could not find signing key '"+signingKID+"'", nil)
+	}
+}
+
+// loadLegacyKey is used to load the private key of deployments that predate
+// keys directory as the only key, its public key is derived from it
+func loadLegacyKey() {
+	pem, err := ioutil.ReadFile(legacyPrivateKey)
 	if err != nil {
-		LogError(LFatal, "Could not read './secrets/public.pem' file", err)
+		LogError(LFatal, "Could not read '"+legacyPrivateKey+"' file", err)
 	}
 
-	publicKey, err = jwt.ParseRSAPublicKeyFromPEM(pub)
+	privateKey, err = jwt.ParseRSAPrivateKeyFromPEM(pem)
 	if err != nil {
-		LogError(LFatal, "Could not parse './secrets/public.pem'", err)
+		LogError(LFatal, "Could not parse '"+legacyPrivateKey+"'", err)
+	}
+
+	signingKID = legacyKID
+	publicKeys[legacyKID] = &privateKey.PublicKey
+}
+
+func init() {
+	keysDirEnv = os.Getenv("WISHLIST_KEYSDIR")
+	signingKeyEnv = os.Getenv("WISHLIST_SIGNINGKEY")
+
+	if len(keysDirEnv) == 0 {
+		if _, err := os.Stat(defaultKeysDir); os.IsNotExist(err) {
+			loadLegacyKey()
+			return
+		}
+
+		keysDirEnv = defaultKeysDir
 	}
+
+	loadKeys(keysDirEnv)
 }
@@ -2,7 +2,9 @@ package main
 
 import (
 	"log"
+	"net/http"
 	"os"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/extension"
@@ -14,20 +16,61 @@ import (
 	dbmodel "github.com/ryakosh/wishlist/lib/db/model"
 	"github.com/ryakosh/wishlist/lib/graph"
 	"github.com/ryakosh/wishlist/lib/graph/generated"
+	"github.com/ryakosh/wishlist/lib/policy"
+	"github.com/ryakosh/wishlist/lib/throttle"
 )
 
 const (
 	defaultPort              = "8080"
 	logsDir                  = "./logs/"
 	defaultRequestComplexity = 10
+
+	// purgeInterval is how often accounts whose deletion grace
+	// period has passed are purged
+	purgeInterval = time.Hour
+
+	// remindInterval is how often occasions are checked for reminders,
+	// friends are reminded only once per occurrence of an occasion
+	remindInterval = time.Hour
 )
 
 var accessLog *log.Logger
 
+var (
+	userLoginPolicy = throttle.Policy{
+		FreeAttempts:    3,
+		BaseDelay:       time.Second,
+		MaxDelay:        time.Minute * 5,
+		LockoutAfter:    10,
+		LockoutDuration: time.Minute * 15,
+		Window:          time.Hour,
+	}
+
+	ipLoginPolicy = throttle.Policy{
+		FreeAttempts:    20,
+		BaseDelay:       time.Second,
+		MaxDelay:        time.Minute,
+		LockoutAfter:    100,
+		LockoutDuration: time.Hour,
+		Window:          time.Hour,
+	}
+)
+
 func graphqlHandler() gin.HandlerFunc {
-	config := generated.Config{Resolvers: &graph.Resolver{DB: db.DB}}
+	attemptStore := dbmodel.AttemptStore{}
+	resolver := &graph.Resolver{
+		DB:          db.DB,
+		UserLimiter: throttle.New("user", attemptStore, userLoginPolicy),
+		IPLimiter:   throttle.New("ip", attemptStore, ipLoginPolicy),
+		Enforcer:    &policy.Enforcer{AreFriends: dbmodel.AreFriends},
+	}
+	config := generated.Config{Resolvers: resolver}
 	config.Directives.AuthRequired = dbmodel.AuthRequired
+	config.Directives.AuthOptional = dbmodel.AuthOptional
 	config.Directives.EmailVerificationRequired = dbmodel.EmailVerificationRequired
+	config.Directives.AdminOnly = dbmodel.AdminOnly
+	config.Directives.HasScope = graph.HasScopeDirective
+	config.Directives.Policy = resolver.PolicyDirective
 	calcComplexity(&config.Complexity)
 
 	h := handler.NewDefaultServer(generated.NewExecutableSchema(config))
@@ -45,6 +88,7 @@ func calcComplexity(complexityRoot *generated.ComplexityRoot) {
 
 	complexityRoot.Users.Query = calcUsersComplexity
 	complexityRoot.Wishes.Query = calcUsersComplexity
+	complexityRoot.Wishlists.Query = calcUsersComplexity
 }
 
 func playgroundHandler() gin.HandlerFunc {
@@ -55,6 +99,13 @@ func playgroundHandler() gin.HandlerFunc {
 	}
 }
 
+func jwksHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Header("Cache-Control", "public, max-age=3600")
+		c.JSON(http.StatusOK, lib.JWKS())
+	}
+}
+
 func accessLogger() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		remoteAddr := c.Request.RemoteAddr
@@ -117,6 +168,11 @@ func main() {
 	r.Use(accessLogger(), lib.GinCtxToCtx())
 	r.POST("/query", graphqlHandler())
 	r.GET("/", playgroundHandler())
+	r.GET("/.well-known/jwks.json", jwksHandler())
+
+	go dbmodel.PurgeScheduledDeletions(purgeInterval)
+	go dbmodel.RemindOccasions(remindInterval, graph.SendOccasionReminder)
+
 	r.Run()
 }
 